@@ -0,0 +1,82 @@
+package day01
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/liviro/aoc/internal/aoc"
+)
+
+// extractDepths returns the depth measurements in the given file.
+func extractDepths(r io.Reader) ([]int, error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	strDepths := strings.Split(strings.TrimSpace(string(raw)), "\n")
+	depths := make([]int, len(strDepths))
+	for i, sd := range strDepths {
+		d, err := strconv.Atoi(sd)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert to int: %s", sd)
+		}
+		depths[i] = d
+	}
+	return depths, nil
+}
+
+// countIncreases returns how many times a depth is larger than the previous one.
+func countIncreases(depths []int) int {
+	increases := 0
+	prev := math.MaxInt64
+	for _, d := range depths {
+		if d > prev {
+			increases += 1
+		}
+		prev = d
+	}
+	return increases
+}
+
+// countWindowIncreases returns how many times the sum of a three-measurement
+// sliding window is larger than the previous one.
+func countWindowIncreases(depths []int) int {
+	increases := 0
+	prev := math.MaxInt64
+	for i := range depths {
+		if i >= len(depths)-2 {
+			break
+		}
+		sum := depths[i] + depths[i+1] + depths[i+2]
+		if sum > prev {
+			increases += 1
+		}
+		prev = sum
+	}
+	return increases
+}
+
+func init() {
+	aoc.Register(2021, 1, func() aoc.Solver { return &solver{} })
+}
+
+// solver holds the depth measurements of the sonar sweep.
+type solver struct {
+	depths []int
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.depths, err = extractDepths(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(countIncreases(s.depths)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(countWindowIncreases(s.depths)), nil
+}
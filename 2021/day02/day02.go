@@ -1,11 +1,14 @@
-package main
+package day02
 
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"strings"
+
+	"github.com/liviro/aoc/internal/aoc"
 )
 
 type Move struct {
@@ -13,14 +16,9 @@ type Move struct {
 	amt int
 }
 
-func extractMoves(fileName string) ([]Move, error) {
-	fp, err := os.Open(fileName)
-	if err != nil {
-		return nil, err
-	}
-	defer fp.Close()
+func extractMoves(r io.Reader) ([]Move, error) {
 	var ms []Move
-	s := bufio.NewScanner(fp)
+	s := bufio.NewScanner(r)
 	s.Split(bufio.ScanLines)
 	for s.Scan() {
 		l := strings.Split(s.Text(), " ")
@@ -74,14 +72,24 @@ func finalLocalePart2(moves []Move) int {
 	return pos * dep
 }
 
-func main() {
-	ms, err := extractMoves("input.txt")
-	if err != nil {
-		fmt.Fprintln(os.Stderr, "Input ingestion went wrong: ", err)
-		os.Exit(1)
-	}
-	part1 := finalLocalePart1(ms)
-	part2 := finalLocalePart2(ms)
-	fmt.Println("Part 1: ", part1)
-	fmt.Println("Part 2: ", part2)
+func init() {
+	aoc.Register(2021, 2, func() aoc.Solver { return &solver{} })
+}
+
+// solver holds the planned course of the submarine.
+type solver struct {
+	moves []Move
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.moves, err = extractMoves(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(finalLocalePart1(s.moves)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(finalLocalePart2(s.moves)), nil
 }
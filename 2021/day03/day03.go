@@ -1,25 +1,23 @@
-package main
+package day03
 
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
+
+	"github.com/liviro/aoc/internal/aoc"
 )
 
-func extractReport(fileName string) ([]string, error) {
-	fp, err := os.Open(fileName)
-	if err != nil {
-		return nil, err
-	}
-	defer fp.Close()
-	var r []string
-	s := bufio.NewScanner(fp)
+func extractReport(r io.Reader) ([]string, error) {
+	var rep []string
+	s := bufio.NewScanner(r)
 	s.Split(bufio.ScanLines)
 	for s.Scan() {
-		r = append(r, s.Text())
+		rep = append(rep, s.Text())
 	}
-	return r, s.Err()
+	return rep, s.Err()
 }
 
 func flip(b string) string {
@@ -102,14 +100,24 @@ func getLifeSupportRating(report []string) int64 {
 	return getRating(report, "oxygen") * getRating(report, "co2")
 }
 
-func main() {
-	r, err := extractReport("input.txt")
-	if err != nil {
-		fmt.Fprintln(os.Stderr, "Input ingestion went wrong: ", err)
-		os.Exit(1)
-	}
-	part1 := getPower(r)
-	part2 := getLifeSupportRating(r)
-	fmt.Println("Part 1: ", part1)
-	fmt.Println("Part 2: ", part2)
+func init() {
+	aoc.Register(2021, 3, func() aoc.Solver { return &solver{} })
+}
+
+// solver holds the diagnostic report.
+type solver struct {
+	report []string
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.report, err = extractReport(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(int(getPower(s.report))), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(int(getLifeSupportRating(s.report))), nil
 }
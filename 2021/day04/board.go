@@ -1,9 +1,6 @@
-package main
+package day04
 
-import (
-	"strconv"
-	"strings"
-)
+import "github.com/liviro/aoc/internal/parse"
 
 const size = 5
 
@@ -14,15 +11,19 @@ type cell struct {
 
 type board [size][size]cell
 
-// parseBoard parses and returns a new board from the raw input.
-func parseBoard(raw string) (board, error) {
-	rows := strings.Split(raw, "\n")
+// parseBoard parses and returns a new board from its input rows.
+func parseBoard(rows []parse.Span) (board, error) {
 	var b board
+	if len(rows) != size {
+		return board{}, rows[0].Errorf("board has %d rows, want %d", len(rows), size)
+	}
 	for i := 0; i < size; i++ {
-		// TrimSpace to handle the right-aligned nums at index 0.
-		row := strings.Fields(strings.TrimSpace(rows[i]))
+		row := parse.Fields(rows[i])
+		if len(row) != size {
+			return board{}, rows[i].Errorf("board row has %d numbers, want %d", len(row), size)
+		}
 		for j := 0; j < size; j++ {
-			num, err := strconv.Atoi(row[j])
+			num, err := row[j].Int()
 			if err != nil {
 				return board{}, err
 			}
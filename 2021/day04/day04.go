@@ -1,21 +1,23 @@
-package main
+package day04
 
 import (
-	"fmt"
-	"os"
-	"strconv"
-	"strings"
+	"errors"
+	"io"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
 )
 
-func extractInput(fileName string) ([]int64, []board, error) {
-	raw, err := os.ReadFile(fileName)
+func extractInput(r io.Reader) ([]int64, []board, error) {
+	blocks, err := parse.Sections(r)
 	if err != nil {
 		return nil, nil, err
 	}
+	if len(blocks) < 2 || len(blocks[0]) != 1 {
+		return nil, nil, errors.New("expected a line of draws followed by boards")
+	}
 
-	blocks := strings.Split(strings.TrimSpace(string(raw)), "\n\n")
-
-	ds, err := parseDraws(blocks[0])
+	ds, err := parseDraws(blocks[0][0])
 	if err != nil {
 		return nil, nil, err
 	}
@@ -28,11 +30,11 @@ func extractInput(fileName string) ([]int64, []board, error) {
 	return ds, bs, nil
 }
 
-func parseDraws(raw string) ([]int64, error) {
-	split := strings.Split(raw, ",")
+func parseDraws(l parse.Span) ([]int64, error) {
+	split := parse.Split(l, ",")
 	draws := make([]int64, len(split))
 	for i, s := range split {
-		d, err := strconv.Atoi(s)
+		d, err := s.Int()
 		if err != nil {
 			return nil, err
 		}
@@ -41,7 +43,7 @@ func parseDraws(raw string) ([]int64, error) {
 	return draws, nil
 }
 
-func parseBoards(raw []string) ([]board, error) {
+func parseBoards(raw [][]parse.Span) ([]board, error) {
 	bs := make([]board, len(raw))
 	for i, s := range raw {
 		board, err := parseBoard(s)
@@ -89,13 +91,25 @@ func lastWinningScore(draws []int64, boards []board) int64 {
 	return 0
 }
 
-func main() {
-	ds, bs, err := extractInput("input.txt")
-	if err != nil {
-		fmt.Fprintln(os.Stderr, "Input ingestion went wrong: ", err)
-		os.Exit(1)
-	}
+func init() {
+	aoc.Register(2021, 4, func() aoc.Solver { return &solver{} })
+}
+
+// solver holds the drawn numbers and the bingo boards.
+type solver struct {
+	draws  []int64
+	boards []board
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.draws, s.boards, err = extractInput(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(int(firstWinningScore(s.draws, s.boards))), nil
+}
 
-	fmt.Println("Part 1:", firstWinningScore(ds, bs))
-	fmt.Println("Part 2:", lastWinningScore(ds, bs))
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(int(lastWinningScore(s.draws, s.boards))), nil
 }
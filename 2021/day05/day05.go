@@ -1,11 +1,12 @@
-package main
+package day05
 
 import (
 	"bufio"
-	"fmt"
-	"os"
+	"io"
 	"strconv"
 	"strings"
+
+	"github.com/liviro/aoc/internal/aoc"
 )
 
 // minMax orders the input int64 pair from smaller to larger.
@@ -107,14 +108,9 @@ func (l line) diagonalPoints() []point {
 }
 
 // extractLines parses and returns all lines in the input file.
-func extractLines(fileName string) ([]line, error) {
-	fp, err := os.Open(fileName)
-	if err != nil {
-		return nil, err
-	}
-	defer fp.Close()
+func extractLines(r io.Reader) ([]line, error) {
 	var ls []line
-	s := bufio.NewScanner(fp)
+	s := bufio.NewScanner(r)
 	s.Split(bufio.ScanLines)
 	for s.Scan() {
 		l, err := parseLine(s.Text())
@@ -159,12 +155,24 @@ func countAllOverlaps(lines []line) int {
 	return countOverlaps(pts)
 }
 
-func main() {
-	ls, err := extractLines("input.txt")
-	if err != nil {
-		fmt.Fprintln(os.Stderr, "Input ingestion went wrong: ", err)
-		os.Exit(1)
-	}
-	fmt.Println("Part 1:", countGridLineOverlaps(ls))
-	fmt.Println("Part 2:", countAllOverlaps(ls))
+func init() {
+	aoc.Register(2021, 5, func() aoc.Solver { return &solver{} })
+}
+
+// solver holds the lines of hydrothermal vents.
+type solver struct {
+	lines []line
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.lines, err = extractLines(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(countGridLineOverlaps(s.lines)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(countAllOverlaps(s.lines)), nil
 }
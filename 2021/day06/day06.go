@@ -1,10 +1,11 @@
-package main
+package day06
 
 import (
-	"fmt"
-	"os"
+	"io"
 	"strconv"
 	"strings"
+
+	"github.com/liviro/aoc/internal/aoc"
 )
 
 type fish struct {
@@ -34,8 +35,8 @@ func tick(f fish) (oldF fish, newF *fish) {
 type school map[fish]int64
 
 // extractSchool extracts the input school of fish in the given file name.
-func extractSchool(fileName string) (school, error) {
-	raw, err := os.ReadFile(fileName)
+func extractSchool(r io.Reader) (school, error) {
+	raw, err := io.ReadAll(r)
 	if err != nil {
 		return nil, err
 	}
@@ -82,12 +83,24 @@ func laterSchoolSize(s school, d int) int64 {
 	return ns.size()
 }
 
-func main() {
-	s, err := extractSchool("input.txt")
-	if err != nil {
-		fmt.Fprintln(os.Stderr, "Input ingestion went wrong: ", err)
-		os.Exit(1)
-	}
-	fmt.Println("Part 1:", laterSchoolSize(s, 80))
-	fmt.Println("Part 2:", laterSchoolSize(s, 256))
+func init() {
+	aoc.Register(2021, 6, func() aoc.Solver { return &solver{} })
+}
+
+// solver holds the initial school of lanternfish.
+type solver struct {
+	school school
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.school, err = extractSchool(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(int(laterSchoolSize(s.school, 80))), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(int(laterSchoolSize(s.school, 256))), nil
 }
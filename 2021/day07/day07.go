@@ -1,19 +1,20 @@
-package main
+package day07
 
 import (
-	"fmt"
+	"io"
 	"math"
-	"os"
 	"strconv"
 	"strings"
+
+	"github.com/liviro/aoc/internal/aoc"
 )
 
 func abs(a int) int {
-  if a < 0 {
-    return -1*a
-  } else {
-    return a
-  }
+	if a < 0 {
+		return -1 * a
+	} else {
+		return a
+	}
 }
 
 // crabArmy represents the crab swarm.
@@ -46,38 +47,38 @@ func parseCrabArmy(raw []string) (crabArmy, error) {
 
 // linearFuelCost computes the cost of moving the crab army to the given place if each step costs 1 fuel.
 func (ca crabArmy) linearFuelCost(place int) int {
-  c := 0
-  for p, n := range ca.pos {
-    c += abs(place - p) * n
-  }
-  return c
+	c := 0
+	for p, n := range ca.pos {
+		c += abs(place-p) * n
+	}
+	return c
 }
 
 // triangularFuelCost computes the cost of moving the crab army to the given place if each additional step costs 1 more fuel than the previous.
 func (ca crabArmy) triangularFuelCost(place int) int {
-  c := 0
-  for p, n := range ca.pos {
-    steps := abs(place - p)
-    c += steps * (steps+1) * n / 2
-  }
-  return c
+	c := 0
+	for p, n := range ca.pos {
+		steps := abs(place - p)
+		c += steps * (steps + 1) * n / 2
+	}
+	return c
 }
 
 // optimalFuelCost gets the optimal fuel cost of lining up the crab army, given the fuel cost function provided.
 func (ca crabArmy) optimalFuelCost(costCalculator func(int) int) int {
-  minCost := math.MaxInt64
-  for p := ca.min; p <= ca.max; p++ {
-    cost := costCalculator(p)
-    if cost < minCost {
-      minCost = cost
-    }
-  }
-  return minCost
+	minCost := math.MaxInt64
+	for p := ca.min; p <= ca.max; p++ {
+		cost := costCalculator(p)
+		if cost < minCost {
+			minCost = cost
+		}
+	}
+	return minCost
 }
 
 // extractCrabArmy extracts the crab army from the given input file.
-func extractCrabArmy(fileName string) (crabArmy, error) {
-	raw, err := os.ReadFile(fileName)
+func extractCrabArmy(r io.Reader) (crabArmy, error) {
+	raw, err := io.ReadAll(r)
 	if err != nil {
 		return crabArmy{}, err
 	}
@@ -89,14 +90,24 @@ func extractCrabArmy(fileName string) (crabArmy, error) {
 	return ca, nil
 }
 
+func init() {
+	aoc.Register(2021, 7, func() aoc.Solver { return &solver{} })
+}
+
+// solver holds the crab army.
+type solver struct {
+	army crabArmy
+}
 
-func main() {
-	ca, err := extractCrabArmy("input.txt")
-	if err != nil {
-		fmt.Fprintln(os.Stderr, "Input ingestion went wrong: ", err)
-		os.Exit(1)
-	}
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.army, err = extractCrabArmy(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(s.army.optimalFuelCost(s.army.linearFuelCost)), nil
+}
 
-    fmt.Println("Part 1:", ca.optimalFuelCost(ca.linearFuelCost))
-    fmt.Println("Part 2:", ca.optimalFuelCost(ca.triangularFuelCost))
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(s.army.optimalFuelCost(s.army.triangularFuelCost)), nil
 }
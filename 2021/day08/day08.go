@@ -1,11 +1,12 @@
-package main
+package day08
 
 import (
 	"bufio"
-	"fmt"
-	"os"
+	"io"
 	"sort"
 	"strings"
+
+	"github.com/liviro/aoc/internal/aoc"
 )
 
 // digit is an ordered string of the segments indicators, concatenated.
@@ -165,14 +166,9 @@ func (d display) count1478() int {
 }
 
 // extractDisplays extracts displays from the given file.
-func extractDisplays(fileName string) ([]display, error) {
-	fp, err := os.Open(fileName)
-	if err != nil {
-		return nil, err
-	}
-	defer fp.Close()
+func extractDisplays(r io.Reader) ([]display, error) {
 	var ds []display
-	s := bufio.NewScanner(fp)
+	s := bufio.NewScanner(r)
 	s.Split(bufio.ScanLines)
 	for s.Scan() {
 		ds = append(ds, parseDisplay(s.Text()))
@@ -198,12 +194,24 @@ func sumAllOutputs(ds []display) int {
 	return s
 }
 
-func main() {
-	ds, err := extractDisplays("input.txt")
-	if err != nil {
-		fmt.Fprintln(os.Stderr, "Input ingestion went wrong: ", err)
-		os.Exit(1)
-	}
-	fmt.Println("Part 1:", countAll1478(ds))
-	fmt.Println("Part 2:", sumAllOutputs(ds))
+func init() {
+	aoc.Register(2021, 8, func() aoc.Solver { return &solver{} })
+}
+
+// solver holds the decoded displays.
+type solver struct {
+	displays []display
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.displays, err = extractDisplays(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(countAll1478(s.displays)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(sumAllOutputs(s.displays)), nil
 }
@@ -0,0 +1,121 @@
+package day09
+
+import (
+	"io"
+	"sort"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/grid"
+)
+
+// isIn returns whether the given candidate location is in the given slice of locations.
+func isIn(ls []grid.Point, candidate grid.Point) bool {
+	for _, l := range ls {
+		if l == candidate {
+			return true
+		}
+	}
+	return false
+}
+
+// heightmap holds the height of each location of the cave floor.
+type heightmap struct {
+	*grid.Grid[int]
+}
+
+// isLocalMin returns true if the given location is lower than all its neighbors.
+func (hm heightmap) isLocalMin(l grid.Point) bool {
+	for _, n := range hm.Neighbors4(l) {
+		if hm.At(l) >= hm.At(n) {
+			return false
+		}
+	}
+	return true
+}
+
+// lowPointRiskLevelSum returns the sum of the risk levels of the low points of the heightmap.
+func (hm heightmap) lowPointRiskLevelSum() int {
+	s := 0
+	for l, p := range hm.All() {
+		if hm.isLocalMin(l) {
+			s += p + 1
+		}
+	}
+	return s
+}
+
+// fullBasin returns all locations that are part of the same basin as the given start location.
+func (hm heightmap) fullBasin(start grid.Point) []grid.Point {
+	hms := hm.W * hm.H
+	q := make(chan grid.Point, hms)
+	bm := make(map[grid.Point]bool)
+	qd := make(map[grid.Point]bool)
+	q <- start
+L:
+	for {
+		select {
+		case l := <-q:
+			bm[l] = true
+			ns := hm.Neighbors4(l)
+			for _, n := range ns {
+				_, inBasin := bm[n]
+				_, queued := qd[n]
+				if !inBasin && !queued && hm.At(n) != 9 {
+					q <- n
+					qd[n] = true
+
+				}
+			}
+		default:
+			break L
+		}
+	}
+	var basin []grid.Point
+	for b := range bm {
+		basin = append(basin, b)
+	}
+	return basin
+}
+
+// bigBasinsProduct returns the product of the sizes of the three biggest basins of the heightmap.
+func (hm heightmap) bigBasinsProduct() int {
+	var sizes []int
+	var seen []grid.Point
+	for l, h := range hm.All() {
+		if !isIn(seen, l) && h != 9 {
+			b := hm.fullBasin(l)
+			seen = append(seen, b...)
+			sizes = append(sizes, len(b))
+		}
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(sizes)))
+	return sizes[0] * sizes[1] * sizes[2]
+}
+
+// extractHeightmap returns the heighmap found in the input file.
+func extractHeightmap(r io.Reader) (heightmap, error) {
+	g, err := grid.Read(r, grid.Digit)
+	return heightmap{g}, err
+}
+
+func init() {
+	aoc.Register(2021, 9, func() aoc.Solver { return &solver{} })
+}
+
+// solver holds the heightmap of the cave floor.
+type solver struct {
+	hm heightmap
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.hm, err = extractHeightmap(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(s.hm.lowPointRiskLevelSum()), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(s.hm.bigBasinsProduct()), nil
+}
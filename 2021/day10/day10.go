@@ -1,21 +1,17 @@
-package main
+package day10
 
 import (
 	"bufio"
-	"fmt"
-	"os"
+	"io"
 	"sort"
+
+	"github.com/liviro/aoc/internal/aoc"
 )
 
 // extractLines returns the lines in the input file.
-func extractLines(fileName string) ([]string, error) {
-	fp, err := os.Open(fileName)
-	if err != nil {
-		return nil, err
-	}
-	defer fp.Close()
+func extractLines(r io.Reader) ([]string, error) {
 	var ls []string
-	s := bufio.NewScanner(fp)
+	s := bufio.NewScanner(r)
 	s.Split(bufio.ScanLines)
 	for s.Scan() {
 		ls = append(ls, s.Text())
@@ -139,12 +135,24 @@ L:
 	return scores[(len(scores)-1)/2]
 }
 
-func main() {
-	ls, err := extractLines("input.txt")
-	if err != nil {
-		fmt.Fprintln(os.Stderr, "Input ingestion went wrong: ", err)
-		os.Exit(1)
-	}
-	fmt.Println("Part 1:", corruptedScoreSum(ls))
-	fmt.Println("Part 2:", completionWinner(ls))
+func init() {
+	aoc.Register(2021, 10, func() aoc.Solver { return &solver{} })
+}
+
+// solver holds the lines of the navigation subsystem.
+type solver struct {
+	lines []string
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.lines, err = extractLines(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(corruptedScoreSum(s.lines)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(completionWinner(s.lines)), nil
 }
@@ -0,0 +1,160 @@
+package day11
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/liviro/aoc/internal/anim"
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/grid"
+)
+
+// octopus is the cute, flashy critter with an energy level.
+type octopus struct {
+	energy   int
+	flashing bool
+}
+
+// parseOctopus returns an octopus of the energy level given in the raw input character.
+func parseOctopus(r rune) (octopus, error) {
+	e, err := grid.Digit(r)
+	return octopus{energy: e}, err
+}
+
+// bump increases the octopus' energy level, and, if applicable, makes it flash.
+func (octo *octopus) bump() {
+	if octo.flashing {
+		return
+	}
+	octo.energy += 1
+	if octo.energy > 9 {
+		octo.flashing = true
+	}
+}
+
+// cavern is the 10x10 grid of octopuses.
+type cavern struct {
+	*grid.Grid[octopus]
+}
+
+// reset puts a fresh octopus (not flashing, energy level at 0) at the given location in the cavern.
+func (g cavern) reset(l grid.Point) {
+	g.Set(l, octopus{})
+}
+
+// step advances the cavern by one step and returns the number of flashes that happpened during it.
+func (g cavern) step() int {
+	// Each location may have at most 8 flashing neighbors.
+	flashNeighbors := make(chan grid.Point, g.W*g.H*8)
+	flashes := make(map[grid.Point]bool)
+	// Bump everyone by 1.
+	for l := range g.All() {
+		bumpInStep(g, l, flashNeighbors, flashes)
+	}
+L:
+	for {
+		// Bump all adjacents of flashing octopuses.
+		select {
+		case l := <-flashNeighbors:
+			bumpInStep(g, l, flashNeighbors, flashes)
+		default:
+			break L
+		}
+	}
+
+	// Reset the energy and flash status of the flashing octopuses, and count them.
+	c := 0
+	for f := range flashes {
+		c += 1
+		g.reset(f)
+	}
+	return c
+}
+
+// bumpInStep bumps the octopus at the given location, and if it then flashes, queues its adjacents to be bumped.
+// This is a helper to the step method.
+func bumpInStep(g cavern, l grid.Point, flashNeighbors chan grid.Point, flashes map[grid.Point]bool) {
+	octo := g.At(l)
+	octo.bump()
+	g.Set(l, octo)
+	if _, ok := flashes[l]; octo.flashing && !ok {
+		flashes[l] = true
+		for _, a := range g.Neighbors8(l) {
+			// Already flashing adjacents won't be affected by this extra bump.
+			if _, ok := flashes[a]; !ok {
+				flashNeighbors <- a
+			}
+		}
+	}
+}
+
+// String draws the energy levels of the octopuses, with those that just flashed as *.
+func (g cavern) String() string {
+	return g.Render(func(_ grid.Point, octo octopus) rune {
+		if octo.energy == 0 {
+			return '*'
+		}
+		return rune('0' + octo.energy)
+	})
+}
+
+// flashesAfter returns the number of total flashes the cavern will see after the given number of steps, emitting
+// the cavern after each step into frames.
+// Note that this method works on a copy of the cavern and does not mutate it.
+func (c cavern) flashesAfter(steps int, frames anim.Sink) int {
+	g := cavern{c.Clone()}
+	f := 0
+	for i := 1; i <= steps; i++ {
+		n := g.step()
+		f += n
+		anim.Emit(frames, fmt.Sprintf("part 1, step %d, %d flashes", i, n), g.String)
+	}
+	return f
+}
+
+// firstSyncStep returns the number of steps it takes for all octopuses to flash in sync for the first time, emitting
+// the cavern after each step into frames.
+// Note that this method works on a copy of the cavern and does not mutate it.
+func (c cavern) firstSyncStep(frames anim.Sink) int {
+	g := cavern{c.Clone()}
+	for i := 1; ; i++ {
+		f := g.step()
+		anim.Emit(frames, fmt.Sprintf("part 2, step %d, %d flashes", i, f), g.String)
+		if f == g.W*g.H {
+			return i
+		}
+	}
+}
+
+// extractCavern extracts the cavern in the given file.
+func extractCavern(r io.Reader) (cavern, error) {
+	g, err := grid.Read(r, parseOctopus)
+	return cavern{g}, err
+}
+
+func init() {
+	aoc.Register(2021, 11, func() aoc.Solver { return &solver{} })
+}
+
+// solver holds the initial octopus cavern.
+type solver struct {
+	cavern cavern
+	frames anim.Sink
+}
+
+func (s *solver) Animate(frames anim.Sink) {
+	s.frames = frames
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.cavern, err = extractCavern(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(s.cavern.flashesAfter(100, s.frames)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(s.cavern.firstSyncStep(s.frames)), nil
+}
@@ -1,13 +1,14 @@
-package main
+package day12
 
 import (
 	"bufio"
-	"fmt"
-	"os"
+	"io"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"unicode"
+
+	"github.com/liviro/aoc/internal/aoc"
 )
 
 // A cave is a node in the tunnel graph.
@@ -60,13 +61,8 @@ func (t tunnelSystem) addTunnel(a, b cave) {
 }
 
 // extractTunnelSystem reads in the tunnelSystem from the given file.
-func extractTunnelSystem(fileName string) (tunnelSystem, error) {
-	fp, err := os.Open(fileName)
-	if err != nil {
-		return nil, err
-	}
-	defer fp.Close()
-	s := bufio.NewScanner(fp)
+func extractTunnelSystem(r io.Reader) (tunnelSystem, error) {
+	s := bufio.NewScanner(r)
 	s.Split(bufio.ScanLines)
 	ts := make(tunnelSystem)
 	for s.Scan() {
@@ -124,12 +120,24 @@ func (t tunnelSystem) pathsCount(smallCaveCheck func(path, cave) bool) int32 {
 	return c
 }
 
-func main() {
-	ts, err := extractTunnelSystem("input.txt")
-	if err != nil {
-		fmt.Fprintln(os.Stderr, "Input ingestion went wrong: ", err)
-		os.Exit(1)
-	}
-	fmt.Println("Part 1:", ts.pathsCount(onlyOnce))
-	fmt.Println("Part 2:", ts.pathsCount(revisitOne))
+func init() {
+	aoc.Register(2021, 12, func() aoc.Solver { return &solver{} })
+}
+
+// solver holds the tunnel system.
+type solver struct {
+	ts tunnelSystem
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.ts, err = extractTunnelSystem(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(int(s.ts.pathsCount(onlyOnce))), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(int(s.ts.pathsCount(revisitOne))), nil
 }
@@ -0,0 +1,181 @@
+package day13
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/liviro/aoc/internal/anim"
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
+)
+
+// fold denotes the direction (x or y) and location of a fold.
+type fold struct {
+	dir string
+	loc int
+}
+
+// parseFold parses a fold out of a line of the format "fold along x=123".
+func parseFold(l parse.Span) (fold, error) {
+	var f fold
+	if err := parse.Match(l, "fold along %s=%d", &f.dir, &f.loc); err != nil {
+		return fold{}, err
+	}
+	if f.dir != "x" && f.dir != "y" {
+		return fold{}, l.Errorf("unknown fold direction %q", f.dir)
+	}
+	return f, nil
+}
+
+// dot denotes a dot at a given location x, y.
+type dot struct{ x, y int }
+
+// parseDot parses a dot out of a line of the format "x,y".
+func parseDot(l parse.Span) (dot, error) {
+	var d dot
+	if err := parse.Match(l, "%d,%d", &d.x, &d.y); err != nil {
+		return dot{}, err
+	}
+	return d, nil
+}
+
+// postFold returns the new dot after a fold was applied.
+func (d dot) postFold(f fold) dot {
+	if f.dir == "x" {
+		if f.loc >= d.x {
+			return d
+		}
+		return dot{2*f.loc - d.x, d.y}
+	}
+	if f.dir == "y" {
+		if f.loc >= d.y {
+			return d
+		}
+		return dot{d.x, 2*f.loc - d.y}
+	}
+	panic("Unknown fold direction!")
+}
+
+// paper represents the collection of dots on the sheet of paper.
+type paper map[dot]bool
+
+// parsePaper parses a paper out of the lines listing its dots.
+func parsePaper(lines []parse.Span) (paper, error) {
+	p := make(paper)
+	for _, l := range lines {
+		d, err := parseDot(l)
+		if err != nil {
+			return nil, err
+		}
+		p[d] = true
+	}
+	return p, nil
+}
+
+// fold returns a new paper that is the result of the given fold.
+func (p paper) fold(f fold) paper {
+	np := make(paper)
+	for d := range p {
+		np[d.postFold(f)] = true
+	}
+	return np
+}
+
+// String() implements the Stringer interface for a paper, allowing it to be printed.
+func (p paper) String() string {
+	var maxX, maxY int
+	for d := range p {
+		if maxX < d.x {
+			maxX = d.x
+		}
+		if maxY < d.y {
+			maxY = d.y
+		}
+	}
+
+	var s string
+	for y := 0; y <= maxY; y++ {
+		var r string
+		for x := 0; x <= maxX; x++ {
+			if p[dot{x, y}] {
+				s += "#"
+			} else {
+				s += "."
+			}
+		}
+		s += r + "\n"
+	}
+	return s
+}
+
+// dotsAfterFold returns how many dots the paper has after the given fold.
+func (p paper) dotsAfterFold(f fold) int {
+	np := p.fold(f)
+	return len(np)
+}
+
+// afterFolds returns a new paper that is the result of applying the given folds to the paper, emitting the paper
+// before and after each fold into frames.
+func (p paper) afterFolds(fs []fold, frames anim.Sink) paper {
+	anim.Emit(frames, "part 2, unfolded", p.String)
+	for i, f := range fs {
+		p = p.fold(f)
+		anim.Emit(frames, fmt.Sprintf("part 2, fold %d along %s=%d", i+1, f.dir, f.loc), p.String)
+	}
+	return p
+}
+
+// extractInput extracts the input paper and folds from the given file.
+func extractInput(r io.Reader) (paper, []fold, error) {
+	blocks, err := parse.Sections(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(blocks) != 2 {
+		return nil, nil, fmt.Errorf("got %d sections, want dots and folds", len(blocks))
+	}
+
+	p, err := parsePaper(blocks[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fs []fold
+	for _, l := range blocks[1] {
+		f, err := parseFold(l)
+		if err != nil {
+			return nil, nil, err
+		}
+		fs = append(fs, f)
+	}
+
+	return p, fs, nil
+}
+
+func init() {
+	aoc.Register(2021, 13, func() aoc.Solver { return &solver{} })
+}
+
+// solver holds the transparent paper and the folding instructions.
+type solver struct {
+	paper  paper
+	folds  []fold
+	frames anim.Sink
+}
+
+func (s *solver) Animate(frames anim.Sink) {
+	s.frames = frames
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.paper, s.folds, err = extractInput(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(s.paper.dotsAfterFold(s.folds[0])), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Render(s.paper.afterFolds(s.folds, s.frames).String()), nil
+}
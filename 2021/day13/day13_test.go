@@ -0,0 +1,61 @@
+package day13
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/liviro/aoc/internal/anim"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// TestRender draws the folds of the example as a GIF, against a golden file.
+func TestRender(t *testing.T) {
+	f, err := os.Open("input-test.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	s := &solver{}
+	if err := s.Parse(f); err != nil {
+		t.Fatal(err)
+	}
+	out := filepath.Join(t.TempDir(), "example.gif")
+	gf, err := os.Create(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer gf.Close()
+	g := anim.NewGIF(gf, anim.DefaultPalette(), 4, 1)
+	s.Animate(g)
+	if _, err := s.Part2(); err != nil {
+		t.Fatal(err)
+	}
+	if g.Len() != 3 {
+		t.Errorf("got %d frames, want the unfolded paper and 2 folds", g.Len())
+	}
+	if err := g.Close(); err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const path = "testdata/example.gif"
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Error("example.gif differs; rerun with -update and look at it")
+	}
+}
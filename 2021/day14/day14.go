@@ -1,10 +1,12 @@
-package main
+package day14
 
 import (
-	"fmt"
+	"errors"
+	"io"
 	"math"
-	"os"
-	"strings"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
 )
 
 // polymer represents a polymer, with its rules and a count of the existing pairs, as well as the last element.
@@ -15,15 +17,21 @@ type polymer struct {
 	pairs map[string]int
 }
 
-// extractRules parses a raw string representation of rules into a map of pairs to their post-step pairs.
-func extractRules(raw string) map[string][]string {
+// extractRules parses the lines of insertion rules into a map of pairs to their post-step pairs.
+func extractRules(lines []parse.Span) (map[string][]string, error) {
 	rs := make(map[string][]string)
-	for _, r := range strings.Split(raw, "\n") {
-		pts := strings.Split(r, " -> ")
-		next := []string{string(pts[0][0]) + pts[1], pts[1] + string(pts[0][1])}
-		rs[pts[0]] = next
+	for _, l := range lines {
+		var pair, ins string
+		if err := parse.Match(l, "%s -> %s", &pair, &ins); err != nil {
+			return nil, err
+		}
+		if len(pair) != 2 || len(ins) != 1 {
+			return nil, l.Errorf("rule should map a pair to one element")
+		}
+		next := []string{string(pair[0]) + ins, ins + string(pair[1])}
+		rs[pair] = next
 	}
-	return rs
+	return rs, nil
 }
 
 // extractPairs extracts the polymer pairs from a string representation of a polymer template.
@@ -36,18 +44,24 @@ func extractPairs(template string) map[string]int {
 }
 
 // extractPolymer extracts a polymer from the given file.
-func extractPolymer(fileName string) (polymer, error) {
-	raw, err := os.ReadFile(fileName)
+func extractPolymer(r io.Reader) (polymer, error) {
+	blocks, err := parse.Sections(r)
 	if err != nil {
 		return polymer{}, err
 	}
+	if len(blocks) != 2 || len(blocks[0]) != 1 {
+		return polymer{}, errors.New("expected a polymer template, a blank line and rules")
+	}
 
-	blocks := strings.Split(strings.TrimSpace(string(raw)), "\n\n")
-
+	rs, err := extractRules(blocks[1])
+	if err != nil {
+		return polymer{}, err
+	}
+	template := blocks[0][0].Text
 	return polymer{
-		rules: extractRules(blocks[1]),
-		last:  rune(blocks[0][len(blocks[0])-1]),
-		pairs: extractPairs(blocks[0]),
+		rules: rs,
+		last:  rune(template[len(template)-1]),
+		pairs: extractPairs(template),
 	}, nil
 }
 
@@ -92,13 +106,24 @@ func (pm polymer) frequencyDeltaAfterSteps(steps int) int {
 	return pm.frequencyDelta()
 }
 
-func main() {
-	p, err := extractPolymer("input.txt")
-	if err != nil {
-		fmt.Fprintln(os.Stderr, "Input ingestion went wrong: ", err)
-		os.Exit(1)
-	}
+func init() {
+	aoc.Register(2021, 14, func() aoc.Solver { return &solver{} })
+}
+
+// solver holds the polymer template and its insertion rules.
+type solver struct {
+	polymer polymer
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.polymer, err = extractPolymer(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(s.polymer.frequencyDeltaAfterSteps(10)), nil
+}
 
-	fmt.Println("Part 1:", p.frequencyDeltaAfterSteps(10))
-	fmt.Println("Part 2:", p.frequencyDeltaAfterSteps(40))
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(s.polymer.frequencyDeltaAfterSteps(40)), nil
 }
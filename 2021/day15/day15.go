@@ -0,0 +1,76 @@
+package day15
+
+import (
+	"io"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/grid"
+	"github.com/liviro/aoc/internal/search"
+)
+
+// riskMap represents the 2-dimensional map of risks.
+type riskMap struct {
+	*grid.Grid[int]
+}
+
+// extracRiskMap returns the risk map represented in the given input file.
+func extractRiskMap(r io.Reader) (riskMap, error) {
+	g, err := grid.Read(r, grid.Digit)
+	return riskMap{g}, err
+}
+
+// blowUp returns the 5x blown up version of the risk map.
+func (rm riskMap) blowUp() riskMap {
+	big := grid.New[int](rm.W*5, rm.H*5)
+	for p, r := range rm.All() {
+		for x := 0; x < 5; x++ {
+			for y := 0; y < 5; y++ {
+				v := r + x + y
+				if v > 9 {
+					v -= 9
+				}
+				big.Set(grid.Point{X: x*rm.W + p.X, Y: y*rm.H + p.Y}, v)
+			}
+		}
+	}
+	return riskMap{big}
+}
+
+// lowestRiskPathCost returns the cost of the lowest-risk path from top left to bottom right in the risk map.
+// Entering a position costs its risk, which is at least 1, so the Manhattan distance to the bottom right
+// never overestimates the remaining cost.
+func (rm riskMap) lowestRiskPathCost() int {
+	end := grid.Point{X: rm.W - 1, Y: rm.H - 1}
+	res := search.AStar(grid.Point{X: 0, Y: 0}, func(p grid.Point) []search.Edge[grid.Point] {
+		var es []search.Edge[grid.Point]
+		for _, n := range rm.Neighbors4(p) {
+			es = append(es, search.Edge[grid.Point]{To: n, Cost: rm.At(n)})
+		}
+		return es
+	}, func(p grid.Point) bool {
+		return p == end
+	}, end.Manhattan)
+	return res.Dist[end]
+}
+
+func init() {
+	aoc.Register(2021, 15, func() aoc.Solver { return &solver{} })
+}
+
+// solver holds the risk map of the cave.
+type solver struct {
+	rm riskMap
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.rm, err = extractRiskMap(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(s.rm.lowestRiskPathCost()), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(s.rm.blowUp().lowestRiskPathCost()), nil
+}
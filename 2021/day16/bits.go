@@ -0,0 +1,194 @@
+package day16
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"unicode"
+)
+
+// The ways in which a transmission can be malformed.
+var (
+	errTruncated     = errors.New("truncated packet")
+	errHex           = errors.New("not a hex digit")
+	errLengthOverrun = errors.New("sub-packets overrun their length")
+	errOverflow      = errors.New("literal overflows int64")
+	errInvalid       = errors.New("invalid packet")
+)
+
+// decodeError is a malformed transmission, at an offset in bits from its
+// start.
+type decodeError struct {
+	offset int64
+	err    error
+	detail string
+}
+
+func (e *decodeError) Error() string {
+	if e.detail == "" {
+		return fmt.Sprintf("bit %d: %v", e.offset, e.err)
+	}
+	return fmt.Sprintf("bit %d: %v: %s", e.offset, e.err, e.detail)
+}
+
+func (e *decodeError) Unwrap() error {
+	return e.err
+}
+
+// bitReader reads the bits of a transmission from its hex digits, as they are
+// needed. The transmission ends at the first space or newline.
+type bitReader struct {
+	r      *bufio.Reader
+	buf    uint64 // Bits read from r but not yet consumed, the next highest.
+	n      int    // The number of bits in buf.
+	offset int64  // The number of bits consumed.
+	end    bool
+}
+
+func newBitReader(r io.Reader) *bitReader {
+	return &bitReader{r: bufio.NewReader(r)}
+}
+
+// read consumes n bits, at most 32, and returns them as a number, the first
+// bit highest.
+func (b *bitReader) read(n int) (uint64, error) {
+	for b.n < n {
+		if err := b.fill(); err != nil {
+			return 0, err
+		}
+	}
+	b.n -= n
+	b.offset += int64(n)
+	v := b.buf >> b.n & (1<<n - 1)
+	return v, nil
+}
+
+// fill reads a hex digit into the buffer.
+func (b *bitReader) fill() error {
+	if b.end {
+		return b.errorf(errTruncated, "")
+	}
+	c, err := b.r.ReadByte()
+	if err == io.EOF || err == nil && unicode.IsSpace(rune(c)) {
+		b.end = true
+		return b.errorf(errTruncated, "")
+	}
+	if err != nil {
+		return err
+	}
+	var d byte
+	switch {
+	case c >= '0' && c <= '9':
+		d = c - '0'
+	case c >= 'A' && c <= 'F':
+		d = c - 'A' + 10
+	case c >= 'a' && c <= 'f':
+		d = c - 'a' + 10
+	default:
+		return &decodeError{offset: b.offset + int64(b.n), err: errHex, detail: fmt.Sprintf("%q", c)}
+	}
+	b.buf = b.buf<<4 | uint64(d)
+	b.n += 4
+	return nil
+}
+
+func (b *bitReader) errorf(err error, format string, args ...any) error {
+	return &decodeError{offset: b.offset, err: err, detail: fmt.Sprintf(format, args...)}
+}
+
+// decode decodes the outermost packet of a transmission in hex. The bits
+// after it are padding and are not read.
+func decode(r io.Reader) (packet, error) {
+	return newBitReader(r).packet()
+}
+
+// packet decodes the packet starting at the next bit, rejecting any that
+// could not be evaluated, as valid would.
+func (b *bitReader) packet() (packet, error) {
+	start := b.offset
+	p, err := b.fields()
+	if err != nil {
+		return p, err
+	}
+	if err := p.check(); err != nil {
+		return p, &decodeError{offset: start, err: errInvalid, detail: err.Error()}
+	}
+	return p, nil
+}
+
+// fields decodes the header and the contents of the packet starting at the
+// next bit.
+func (b *bitReader) fields() (packet, error) {
+	var p packet
+	v, err := b.read(3)
+	if err != nil {
+		return p, err
+	}
+	t, err := b.read(3)
+	if err != nil {
+		return p, err
+	}
+	p.version, p.typeID = int64(v), int64(t)
+	if p.typeID == 4 {
+		p.literal, err = b.literal()
+		return p, err
+	}
+
+	start := b.offset
+	lengthType, err := b.read(1)
+	if err != nil {
+		return p, err
+	}
+	if lengthType == subPacketCount {
+		count, err := b.read(11)
+		if err != nil {
+			return p, err
+		}
+		for range count {
+			sp, err := b.packet()
+			if err != nil {
+				return p, err
+			}
+			p.subPackets = append(p.subPackets, sp)
+		}
+		return p, nil
+	}
+	length, err := b.read(15)
+	if err != nil {
+		return p, err
+	}
+	end := b.offset + int64(length)
+	for b.offset < end {
+		sp, err := b.packet()
+		if err != nil {
+			return p, err
+		}
+		p.subPackets = append(p.subPackets, sp)
+	}
+	if b.offset > end {
+		return p, &decodeError{offset: start, err: errLengthOverrun,
+			detail: fmt.Sprintf("sub-packets take %d bits, not the %d given", b.offset-end+int64(length), length)}
+	}
+	return p, nil
+}
+
+// literal decodes the groups of a literal value.
+func (b *bitReader) literal() (int64, error) {
+	var l uint64
+	for {
+		start := b.offset
+		g, err := b.read(5)
+		if err != nil {
+			return 0, err
+		}
+		if l > math.MaxInt64>>4 {
+			return 0, &decodeError{offset: start, err: errOverflow}
+		}
+		l = l<<4 | g&0xF
+		if g&0x10 == 0 {
+			return int64(l), nil
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package day16
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// hex returns the transmission of the given bits, written as '0' and '1'
+// characters and padded with zeros to whole hex digits.
+func hex(bits string) string {
+	for len(bits)%4 != 0 {
+		bits += "0"
+	}
+	var h strings.Builder
+	for i := 0; i < len(bits); i += 4 {
+		d := (bits[i]-'0')<<3 | (bits[i+1]-'0')<<2 | (bits[i+2]-'0')<<1 | bits[i+3] - '0'
+		h.WriteByte("0123456789ABCDEF"[d])
+	}
+	return h.String()
+}
+
+func TestDecodeErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name, input string
+		err         error
+		msg         string
+	}{
+		{"truncated literal", "D2FE", errTruncated, "bit 16: truncated packet"},
+		{"truncated header", "", errTruncated, "bit 0: truncated packet"},
+		{"newline ends", "D2\nFE28", errTruncated, "bit 6: truncated packet"},
+		{"bad digit", "D2FG28", errHex, `bit 12: not a hex digit: 'G'`},
+		{"overflow", hex("100100" + strings.Repeat("11111", 15) + "10000" + "00001"), errOverflow, "bit 81: literal overflows int64"},
+		// A sum whose 10 bits of sub-packets hold an 11-bit literal.
+		{"overrun", hex("000000" + "0" + "000000000001010" + "000100" + "00001"), errLengthOverrun,
+			"bit 6: sub-packets overrun their length: sub-packets take 11 bits, not the 10 given"},
+		// A greater-than of a single literal, 5.
+		{"one operand", "16004428", errInvalid, "bit 0: invalid packet: comparison gt of 1 sub-packets, not 2"},
+		{"nested operand", hex("000000" + "1" + "00000000001" + "000111" + "1" + "00000000001" + "000100" + "00001"), errInvalid,
+			"bit 18: invalid packet: comparison eq of 1 sub-packets, not 2"},
+		{"truncated count", hex("000000" + "1" + "00000000010" + "000100" + "00001"), errTruncated, "bit 32: truncated packet"},
+	} {
+		_, err := decode(strings.NewReader(tc.input))
+		if !errors.Is(err, tc.err) || err.Error() != tc.msg {
+			t.Errorf("%s: decode(%q) = %v, want %q", tc.name, tc.input, err, tc.msg)
+		}
+	}
+}
+
+func TestDecodeMaxLiteral(t *testing.T) {
+	// 2^63-1: 7 then fifteen Fs, 16 groups.
+	bits := "000100" + "10111" + strings.Repeat("11111", 14) + "01111"
+	p, err := decode(strings.NewReader(hex(bits)))
+	if err != nil || p.literal != 1<<63-1 {
+		t.Errorf("decode() = %v, %v, want (lit 9223372036854775807)", p, err)
+	}
+}
+
+// TestDecodeLarge decodes a transmission of a few megabytes: a sum of a
+// thousand sums of a thousand literals each.
+func TestDecodeLarge(t *testing.T) {
+	inner := packet{typeID: 0}
+	for i := range 1000 {
+		inner.subPackets = append(inner.subPackets, packet{typeID: 4, literal: int64(i)})
+	}
+	outer := packet{typeID: 0}
+	for range 1000 {
+		outer.subPackets = append(outer.subPackets, inner)
+	}
+	h, err := encode(outer, subPacketCount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(h) < 4<<20 {
+		t.Fatalf("transmission of %d digits, want megabytes", len(h))
+	}
+	p, err := decode(strings.NewReader(h))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := p.eval(); got != 1000*(999*1000/2) {
+		t.Errorf("eval() = %d, want %d", got, 1000*(999*1000/2))
+	}
+}
@@ -0,0 +1,114 @@
+package day16
+
+import (
+	"io"
+	"math"
+
+	"github.com/liviro/aoc/internal/aoc"
+)
+
+// packet is the parsed out, structured representation of a packet.
+// Note that literal and subPackets are mutually exclusive: a packet only has one or the other set.
+type packet struct {
+	version    int64
+	typeID     int64
+	literal    int64
+	subPackets []packet
+}
+
+// versionSum returns the sum of all the versions found within a packet and its subpackets.
+func (p packet) versionSum() int64 {
+	s := p.version
+	for _, sp := range p.subPackets {
+		s += sp.versionSum()
+	}
+	return s
+}
+
+// eval returns the result of evaluating the expression in a given packet.
+func (p packet) eval() int64 {
+	switch p.typeID {
+	case 0:
+		s := int64(0)
+		for _, sp := range p.subPackets {
+			s += sp.eval()
+		}
+		return s
+
+	case 1:
+		s := int64(1)
+		for _, sp := range p.subPackets {
+			s *= sp.eval()
+		}
+		return s
+
+	case 2:
+		m := int64(math.MaxInt64)
+		for _, sp := range p.subPackets {
+			e := sp.eval()
+			if e < m {
+				m = e
+			}
+		}
+		return m
+
+	case 3:
+		m := int64(0)
+		for _, sp := range p.subPackets {
+			e := sp.eval()
+			if e > m {
+				m = e
+			}
+		}
+		return m
+
+	case 4:
+		return p.literal
+
+	case 5:
+		if p.subPackets[0].eval() > p.subPackets[1].eval() {
+			return 1
+		} else {
+			return 0
+		}
+
+	case 6:
+		if p.subPackets[0].eval() < p.subPackets[1].eval() {
+			return 1
+		} else {
+			return 0
+		}
+
+	case 7:
+		if p.subPackets[0].eval() == p.subPackets[1].eval() {
+			return 1
+		} else {
+			return 0
+		}
+
+	default:
+		panic("Unknown type ID!")
+	}
+}
+
+func init() {
+	aoc.Register(2021, 16, func() aoc.Solver { return &solver{} })
+}
+
+// solver holds the decoded outermost packet of the transmission.
+type solver struct {
+	packet packet
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.packet, err = decode(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(int(s.packet.versionSum())), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(int(s.packet.eval())), nil
+}
@@ -0,0 +1,86 @@
+package day16
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const debugHelp = `commands:
+  show             print the transmission's packet as an S-expression
+  eval expr        evaluate an S-expression
+  encode [0|1] expr
+                   encode an S-expression in hex, preferring the given
+                   length type (default 1)
+  decode hex       print a transmission's packet as an S-expression
+  help             print this help
+  quit             leave the debugger
+`
+
+// Debug explores the decoded transmission and encodes packets, reading
+// commands from in until it ends or a quit command.
+func (s *solver) Debug(in io.Reader, out io.Writer) error {
+	fmt.Fprintln(out, s.packet)
+	sc := bufio.NewScanner(in)
+	for {
+		fmt.Fprint(out, "(day16) ")
+		if !sc.Scan() {
+			fmt.Fprintln(out)
+			return sc.Err()
+		}
+		cmd, arg, _ := strings.Cut(strings.TrimSpace(sc.Text()), " ")
+		if err := s.command(cmd, strings.TrimSpace(arg), out); err == errQuit {
+			return nil
+		} else if err != nil {
+			fmt.Fprintln(out, "error:", err)
+		}
+	}
+}
+
+var errQuit = errors.New("quit")
+
+func (s *solver) command(cmd, arg string, out io.Writer) error {
+	switch cmd {
+	case "":
+	case "s", "show":
+		fmt.Fprintln(out, s.packet)
+	case "e", "eval":
+		p, err := parseExpr(arg)
+		if err != nil {
+			return err
+		}
+		if err := p.valid(); err != nil {
+			return err
+		}
+		fmt.Fprintln(out, p.eval())
+	case "encode":
+		lengthType := subPacketCount
+		if t, rest, ok := strings.Cut(arg, " "); ok && (t == "0" || t == "1") {
+			lengthType, arg = int(t[0]-'0'), rest
+		}
+		p, err := parseExpr(arg)
+		if err != nil {
+			return err
+		}
+		hex, err := encode(p, lengthType)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, hex)
+	case "decode":
+		p, err := decode(strings.NewReader(arg))
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, p)
+	case "h", "help":
+		fmt.Fprint(out, debugHelp)
+	case "q", "quit":
+		return errQuit
+	default:
+		return fmt.Errorf("unknown command %q; try help", cmd)
+	}
+	return nil
+}
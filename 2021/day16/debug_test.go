@@ -0,0 +1,28 @@
+package day16
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDebug(t *testing.T) {
+	s := &solver{}
+	if err := s.Parse(strings.NewReader("C200B40A82\n")); err != nil {
+		t.Fatal(err)
+	}
+	script := "eval (product (lit 6) (max (lit 1) (lit 9)))\nencode 0 (lt@1 (lit@6 10) (lit@2 20))\neval (sum (gt (lit 1)))\ndecode EE00D40C823060\ndecode D2FE\nquit\nshow\n"
+	var out strings.Builder
+	if err := s.Debug(strings.NewReader(script), &out); err != nil {
+		t.Fatal(err)
+	}
+	want := `(sum@6 (lit@6 1) (lit@2 2))
+(day16) 54
+(day16) 38006F45291200
+(day16) error: comparison gt of 1 sub-packets, not 2
+(day16) (max@7 (lit@2 1) (lit@4 2) (lit@1 3))
+(day16) error: bit 16: truncated packet
+(day16) `
+	if out.String() != want {
+		t.Errorf("Debug() output:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
@@ -0,0 +1,120 @@
+package day16
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Length type IDs of operator packets, telling how their sub-packets are
+// delimited.
+const (
+	// totalLength is followed by the total length in bits of the
+	// sub-packets, in 15 bits.
+	totalLength = 0
+	// subPacketCount is followed by the number of sub-packets, in 11 bits.
+	subPacketCount = 1
+)
+
+// encode returns the transmission of a packet in hex, padded to whole bytes.
+// Operators use the given length type, unless their sub-packets do not fit in
+// it, in which case they use the other.
+func encode(p packet, lengthType int) (string, error) {
+	var b strings.Builder
+	if err := p.encode(&b, lengthType); err != nil {
+		return "", err
+	}
+	for b.Len()%8 != 0 {
+		b.WriteByte('0')
+	}
+	bits := b.String()
+	var h strings.Builder
+	for i := 0; i < len(bits); i += 4 {
+		d := (bits[i]-'0')<<3 | (bits[i+1]-'0')<<2 | (bits[i+2]-'0')<<1 | bits[i+3] - '0'
+		h.WriteByte("0123456789ABCDEF"[d])
+	}
+	return h.String(), nil
+}
+
+// encode writes the packet to b in binary, as '0' and '1' characters.
+func (p packet) encode(b *strings.Builder, lengthType int) error {
+	if err := p.check(); err != nil {
+		return err
+	}
+	writeBits(b, p.version, 3)
+	writeBits(b, p.typeID, 3)
+	if p.typeID == 4 {
+		// The literal in groups of 4 bits, from the highest, each preceded
+		// by a 1 but for the last one.
+		groups := 1
+		for p.literal>>(4*groups) != 0 {
+			groups++
+		}
+		for g := groups - 1; g >= 0; g-- {
+			writeBits(b, min(int64(g), 1), 1)
+			writeBits(b, p.literal>>(4*g), 4)
+		}
+		return nil
+	}
+	var sub strings.Builder
+	for _, sp := range p.subPackets {
+		if err := sp.encode(&sub, lengthType); err != nil {
+			return err
+		}
+	}
+	countFits := len(p.subPackets) < 1<<11
+	lengthFits := sub.Len() < 1<<15
+	switch {
+	case !countFits && !lengthFits:
+		return fmt.Errorf("%d sub-packets, of %d bits, do not fit in either length type", len(p.subPackets), sub.Len())
+	case lengthType == totalLength && lengthFits || !countFits:
+		writeBits(b, totalLength, 1)
+		writeBits(b, int64(sub.Len()), 15)
+	default:
+		writeBits(b, subPacketCount, 1)
+		writeBits(b, int64(len(p.subPackets)), 11)
+	}
+	b.WriteString(sub.String())
+	return nil
+}
+
+// check returns whether the packet can be encoded, leaving its sub-packets
+// to be checked as they are encoded.
+func (p packet) check() error {
+	switch {
+	case p.version < 0 || p.version > 7:
+		return fmt.Errorf("version %d does not fit in 3 bits", p.version)
+	case p.typeID < 0 || p.typeID > 7:
+		return fmt.Errorf("type ID %d does not fit in 3 bits", p.typeID)
+	case p.typeID == 4 && p.literal < 0:
+		return fmt.Errorf("literal %d is negative", p.literal)
+	case p.typeID == 4 && len(p.subPackets) > 0:
+		return errors.New("literal with sub-packets")
+	case p.typeID != 4 && p.literal != 0:
+		return fmt.Errorf("operator %s with a literal", operators[p.typeID])
+	case p.typeID >= 5 && len(p.subPackets) != 2:
+		return fmt.Errorf("comparison %s of %d sub-packets, not 2", operators[p.typeID], len(p.subPackets))
+	}
+	return nil
+}
+
+// valid returns whether the packet and all of its sub-packets can be encoded,
+// and so evaluated.
+func (p packet) valid() error {
+	if err := p.check(); err != nil {
+		return err
+	}
+	for _, sp := range p.subPackets {
+		if err := sp.valid(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeBits writes the low n bits of v to b, from the highest.
+func writeBits(b *strings.Builder, v int64, n int) {
+	for i := n - 1; i >= 0; i-- {
+		b.WriteByte(byte('0' + v>>i&1))
+	}
+}
@@ -0,0 +1,129 @@
+package day16
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func mustDecode(t *testing.T, hex string) packet {
+	t.Helper()
+	p, err := decode(strings.NewReader(hex))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestEncodeExamples(t *testing.T) {
+	for _, tc := range []struct {
+		expr       string
+		lengthType int
+		hex        string
+	}{
+		{"(lit@6 2021)", totalLength, "D2FE28"},
+		{"(lt@1 (lit@6 10) (lit@2 20))", totalLength, "38006F45291200"},
+		{"(max@7 (lit@2 1) (lit@4 2) (lit@1 3))", subPacketCount, "EE00D40C823060"},
+	} {
+		p, err := parseExpr(tc.expr)
+		if err != nil {
+			t.Fatalf("parseExpr(%q): %v", tc.expr, err)
+		}
+		if got, err := encode(p, tc.lengthType); err != nil || got != tc.hex {
+			t.Errorf("encode(%s) = %q, %v, want %q", tc.expr, got, err, tc.hex)
+		}
+		if got := mustDecode(t, tc.hex).String(); got != tc.expr {
+			t.Errorf("decoding %s = %s, want %s", tc.hex, got, tc.expr)
+		}
+	}
+}
+
+// randomPacket returns a random packet tree, of at most the given depth, that
+// can be encoded.
+func randomPacket(rng *rand.Rand, depth int) packet {
+	p := packet{version: rng.Int64N(8)}
+	if depth == 0 || rng.IntN(3) == 0 {
+		p.typeID = 4
+		p.literal = rng.Int64N(1 << (1 + rng.IntN(62)))
+		return p
+	}
+	p.typeID = []int64{0, 1, 2, 3, 5, 6, 7}[rng.IntN(7)]
+	n := 2
+	if p.typeID < 4 {
+		n = rng.IntN(5)
+	}
+	for range n {
+		p.subPackets = append(p.subPackets, randomPacket(rng, depth-1))
+	}
+	return p
+}
+
+func TestRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewPCG(16, 2021))
+	for i := range 500 {
+		p := randomPacket(rng, rng.IntN(6))
+		lengthType := i % 2
+		hex, err := encode(p, lengthType)
+		if err != nil {
+			t.Fatalf("encode(%s): %v", p, err)
+		}
+		if got := mustDecode(t, hex); !reflect.DeepEqual(got, p) {
+			t.Errorf("decode(encode(%s, %d)) = %s", p, lengthType, got)
+		}
+		if got, err := parseExpr(p.String()); err != nil || !reflect.DeepEqual(got, p) {
+			t.Errorf("parseExpr(%s) = %s, %v", p, got, err)
+		}
+	}
+}
+
+func TestEncodeLengthTypeFallback(t *testing.T) {
+	p := packet{typeID: 0}
+	for range 1 << 11 {
+		p.subPackets = append(p.subPackets, packet{typeID: 4, literal: 1})
+	}
+	hex, err := encode(p, subPacketCount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2048 sub-packets do not fit in 11 bits, so the total length is given.
+	if header, _ := newBitReader(strings.NewReader(hex)).read(7); header&1 != totalLength {
+		t.Errorf("encode() used length type %d, want 0", header&1)
+	}
+	if got := mustDecode(t, hex); !reflect.DeepEqual(got, p) {
+		t.Errorf("decode(encode()) differs")
+	}
+}
+
+func TestEncodeErrors(t *testing.T) {
+	for _, tc := range []struct {
+		p   packet
+		err string
+	}{
+		{packet{version: 8, typeID: 4}, "version 8 does not fit in 3 bits"},
+		{packet{typeID: 4, literal: -1}, "literal -1 is negative"},
+		{packet{typeID: 7, subPackets: []packet{{typeID: 4}}}, "comparison eq of 1 sub-packets, not 2"},
+		{packet{typeID: 0, subPackets: []packet{{typeID: 9}}}, "type ID 9 does not fit in 3 bits"},
+	} {
+		if _, err := encode(tc.p, totalLength); err == nil || err.Error() != tc.err {
+			t.Errorf("encode(%+v) = %v, want %q", tc.p, err, tc.err)
+		}
+	}
+}
+
+func TestParseExprErrors(t *testing.T) {
+	for _, tc := range []struct {
+		expr, err string
+	}{
+		{"(sum (lit 1)", "12: unexpected end of expression, want ("},
+		{"(add (lit 1))", `1: unexpected "add", want an operator`},
+		{"(lit x)", `5: unexpected "x", want a number`},
+		{"(lit@v 1)", `1: bad version "v"`},
+		{"(lit 1) (lit 2)", `8: unexpected "(" after the expression`},
+		{"lit 1", `0: unexpected "lit", want (`},
+	} {
+		if _, err := parseExpr(tc.expr); err == nil || err.Error() != tc.err {
+			t.Errorf("parseExpr(%q) = %v, want %q", tc.expr, err, tc.err)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package day16
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// operators names the packet types in S-expressions, by type ID.
+var operators = []string{"sum", "product", "min", "max", "lit", "gt", "lt", "eq"}
+
+// String returns the packet as an S-expression, such as
+// (sum (lit 3) (max (lit 1) (lit 2))). Versions other than 0 follow the
+// operator's name after an @, as in (lit@6 2021).
+func (p packet) String() string {
+	var b strings.Builder
+	p.format(&b)
+	return b.String()
+}
+
+func (p packet) format(b *strings.Builder) {
+	b.WriteString("(" + operators[p.typeID])
+	if p.version != 0 {
+		fmt.Fprintf(b, "@%d", p.version)
+	}
+	if p.typeID == 4 {
+		fmt.Fprintf(b, " %d", p.literal)
+	}
+	for _, sp := range p.subPackets {
+		b.WriteByte(' ')
+		sp.format(b)
+	}
+	b.WriteByte(')')
+}
+
+// parseExpr parses an S-expression, as written by String, into a packet.
+// Errors give the offset in s that they occur at.
+func parseExpr(s string) (packet, error) {
+	e := &exprParser{s: s}
+	p, err := e.packet()
+	if err == nil {
+		if tok, at := e.next(); tok != "" {
+			err = fmt.Errorf("%d: unexpected %q after the expression", at, tok)
+		}
+	}
+	return p, err
+}
+
+// exprParser reads tokens, parentheses and atoms, from an S-expression.
+type exprParser struct {
+	s   string
+	pos int
+}
+
+// next returns the next token and its offset, or "" at the end.
+func (e *exprParser) next() (string, int) {
+	for e.pos < len(e.s) && unicode.IsSpace(rune(e.s[e.pos])) {
+		e.pos++
+	}
+	start := e.pos
+	if e.pos < len(e.s) && (e.s[e.pos] == '(' || e.s[e.pos] == ')') {
+		e.pos++
+		return e.s[start:e.pos], start
+	}
+	for e.pos < len(e.s) && !unicode.IsSpace(rune(e.s[e.pos])) && e.s[e.pos] != '(' && e.s[e.pos] != ')' {
+		e.pos++
+	}
+	return e.s[start:e.pos], start
+}
+
+func (e *exprParser) packet() (packet, error) {
+	var p packet
+	if tok, at := e.next(); tok != "(" {
+		return p, unexpected(tok, at, "(")
+	}
+	head, at := e.next()
+	name, version, hasVersion := strings.Cut(head, "@")
+	typeID := slices.Index(operators, name)
+	if typeID < 0 {
+		return p, unexpected(head, at, "an operator")
+	}
+	p.typeID = int64(typeID)
+	if hasVersion {
+		v, err := strconv.ParseInt(version, 10, 64)
+		if err != nil {
+			return p, fmt.Errorf("%d: bad version %q", at, version)
+		}
+		p.version = v
+	}
+	if p.typeID == 4 {
+		tok, at := e.next()
+		l, err := strconv.ParseInt(tok, 10, 64)
+		if err != nil {
+			return p, unexpected(tok, at, "a number")
+		}
+		p.literal = l
+		if tok, at := e.next(); tok != ")" {
+			return p, unexpected(tok, at, ")")
+		}
+		return p, nil
+	}
+	for {
+		save := e.pos
+		if tok, _ := e.next(); tok == ")" {
+			return p, nil
+		}
+		e.pos = save
+		sp, err := e.packet()
+		if err != nil {
+			return p, err
+		}
+		p.subPackets = append(p.subPackets, sp)
+	}
+}
+
+func unexpected(tok string, at int, want string) error {
+	if tok == "" {
+		return fmt.Errorf("%d: %w, want %s", at, errEnd, want)
+	}
+	return fmt.Errorf("%d: unexpected %q, want %s", at, tok, want)
+}
+
+var errEnd = errors.New("unexpected end of expression")
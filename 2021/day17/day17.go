@@ -1,16 +1,16 @@
-package main
+package day17
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
+)
 
 // target represents the box that makes up the target range, with its max-min x & y values.
 type target struct{ minX, maxX, minY, maxY int }
 
-// test
-// var t = target {20, 30, -10, -5}
-
-// actual input
-var t = target{70, 96, -179, -124}
-
 // max returns the highest among the inputs.
 // This should really go into some common package at this point...
 func max(a, b int) int {
@@ -92,7 +92,40 @@ func (t target) validVelocitiesCount() int {
 	return c
 }
 
-func main() {
-	fmt.Println("Part 1:", t.highestY())
-	fmt.Println("Part 2:", t.validVelocitiesCount())
+// extractTarget extracts the target area from the given file.
+func extractTarget(r io.Reader) (target, error) {
+	ls, err := parse.Lines(r)
+	if err != nil {
+		return target{}, err
+	}
+	if len(ls) != 1 {
+		return target{}, fmt.Errorf("got %d lines, want a single target area", len(ls))
+	}
+	var t target
+	if err := parse.Match(ls[0], "target area: x=%d..%d, y=%d..%d", &t.minX, &t.maxX, &t.minY, &t.maxY); err != nil {
+		return target{}, err
+	}
+	return t, nil
+}
+
+func init() {
+	aoc.Register(2021, 17, func() aoc.Solver { return &solver{} })
+}
+
+// solver holds the target area of the probe.
+type solver struct {
+	target target
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.target, err = extractTarget(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(s.target.highestY()), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(s.target.validVelocitiesCount()), nil
 }
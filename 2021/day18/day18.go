@@ -1,11 +1,15 @@
-package main
+package day18
 
 import (
 	"bufio"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"math"
-	"os"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
 )
 
 // elem denotes a single entity in a pair: either a regular integer, or a pair.
@@ -239,14 +243,27 @@ func add(p1, p2 *pair) *pair {
 	return root
 }
 
-// extractNumbers extracts the input numbers from the given file.
-func extractNumbers(fileName string) ([]*pair, error) {
-	fp, err := os.Open(fileName)
+// extractFlat extracts the input numbers from the given file, flattened.
+func extractFlat(r io.Reader) ([]number, error) {
+	ls, err := parse.Lines(r)
 	if err != nil {
 		return nil, err
 	}
-	defer fp.Close()
-	s := bufio.NewScanner(fp)
+	if len(ls) == 0 {
+		return nil, errors.New("no numbers in the homework")
+	}
+	ns := make([]number, len(ls))
+	for i, l := range ls {
+		if ns[i], err = parseNumber(l); err != nil {
+			return nil, err
+		}
+	}
+	return ns, nil
+}
+
+// extractNumbers extracts the input numbers from the given file.
+func extractNumbers(r io.Reader) ([]*pair, error) {
+	s := bufio.NewScanner(r)
 	var ps []*pair
 	for s.Scan() {
 		ps = append(ps, strToPair(s.Text()))
@@ -283,13 +300,26 @@ func maxSumMagnitude(ps []*pair) int {
 	return max
 }
 
-func main() {
-	ps, err := extractNumbers("input.txt")
-	if err != nil {
-		fmt.Fprintln(os.Stderr, "Input ingestion went wrong: ", err)
-		os.Exit(1)
-	}
-	fmt.Println("Part 1:", sumPairs(ps).magnitude())
-	fmt.Println("Part 2:", maxSumMagnitude(ps))
+func init() {
+	aoc.Register(2021, 18, func() aoc.Solver { return &solver{} })
+}
+
+// solver holds the snailfish numbers of the homework, flattened. The pair
+// trees solve the puzzle too, more slowly, and are kept to check the
+// flattened numbers against.
+type solver struct {
+	numbers []number
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.numbers, err = extractFlat(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(sumNumbers(s.numbers).magnitude()), nil
+}
 
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(maxNumbersMagnitude(s.numbers)), nil
 }
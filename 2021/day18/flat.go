@@ -0,0 +1,218 @@
+package day18
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/liviro/aoc/internal/parse"
+)
+
+// token is a regular number in a flattened snailfish number, with the number
+// of pairs it is nested in.
+type token struct {
+	value, depth int
+}
+
+// number is a snailfish number flattened into its regular numbers, from left
+// to right. The pairs are implied: two neighbouring tokens at the same depth
+// which no other token at that depth sits between form a pair.
+//
+// Unlike pairs, numbers are edited in place, and the operations on them do
+// not allocate once their slices have grown large enough.
+type number []token
+
+// parseNumber parses a snailfish number, such as [[1,2],3].
+func parseNumber(l parse.Span) (number, error) {
+	s := l.Text
+	at := func(i int) parse.Span { return l.Slice(i, i) }
+	var n number
+	depth := 0
+	// expect tells what the grammar allows next: an element, a comma or a
+	// closing bracket.
+	const element, comma, closing = 0, 1, 2
+	expect := element
+	// commas tells, for each open pair, whether its comma was read.
+	var commas []bool
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '[' && expect == element:
+			depth++
+			commas = append(commas, false)
+		case c >= '0' && c <= '9' && expect == element:
+			j := i
+			for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+				j++
+			}
+			v, err := l.Slice(i, j).Int()
+			if err != nil {
+				return nil, err
+			}
+			n = append(n, token{v, depth})
+			i = j - 1
+			expect = comma
+		case c == ',' && expect == comma && depth > 0 && !commas[depth-1]:
+			commas[depth-1] = true
+			expect = element
+		case c == ']' && expect == comma && depth > 0 && commas[depth-1]:
+			commas = commas[:depth-1]
+			depth--
+			expect = comma
+		default:
+			return nil, at(i).Errorf("unexpected %q", c)
+		}
+		if depth == 0 && i < len(s)-1 {
+			return nil, at(i+1).Errorf("unexpected %q after the number", s[i+1])
+		}
+	}
+	if depth != 0 || len(n) < 2 {
+		return nil, at(len(s)).Errorf("unexpected end of number")
+	}
+	return n, nil
+}
+
+// String returns the number written out as a nested pair.
+func (n number) String() string {
+	var b strings.Builder
+	depth := 0
+	// open tells, for each open pair, whether its left element was written.
+	var left []bool
+	for _, t := range n {
+		for depth < t.depth {
+			b.WriteByte('[')
+			left = append(left, false)
+			depth++
+		}
+		b.WriteString(strconv.Itoa(t.value))
+		// Close the pairs that this value completes.
+		for depth > 0 && left[depth-1] {
+			b.WriteByte(']')
+			left = left[:depth-1]
+			depth--
+		}
+		if depth > 0 {
+			left[depth-1] = true
+			b.WriteByte(',')
+		}
+	}
+	return b.String()
+}
+
+// addNumbers writes the reduced sum of a and b into dst, reusing its storage,
+// and returns it. Neither a nor b is modified.
+func addNumbers(dst, a, b number) number {
+	dst = append(append(dst[:0], a...), b...)
+	for i := range dst {
+		dst[i].depth++
+	}
+	return dst.reduce()
+}
+
+// reduce explodes and splits the number until neither applies, and returns
+// it.
+func (n number) reduce() number {
+	for {
+		if n.explode() {
+			continue
+		}
+		var ok bool
+		if n, ok = n.split(); !ok {
+			return n
+		}
+	}
+}
+
+// explode explodes the leftmost pair nested inside four pairs, and returns
+// whether there was one. Such a pair is always of two regular numbers.
+func (n *number) explode() bool {
+	s := *n
+	for i := 0; i+1 < len(s); i++ {
+		if s[i].depth <= 4 || s[i+1].depth != s[i].depth {
+			continue
+		}
+		if i > 0 {
+			s[i-1].value += s[i].value
+		}
+		if i+2 < len(s) {
+			s[i+2].value += s[i+1].value
+		}
+		s[i] = token{0, s[i].depth - 1}
+		*n = append(s[:i+1], s[i+2:]...)
+		return true
+	}
+	return false
+}
+
+// split splits the leftmost regular number of 10 or more into a pair, and
+// returns the number and whether there was one.
+func (n number) split() (number, bool) {
+	for i, t := range n {
+		if t.value < 10 {
+			continue
+		}
+		n = append(n, token{})
+		copy(n[i+2:], n[i+1:])
+		n[i] = token{t.value / 2, t.depth + 1}
+		n[i+1] = token{(t.value + 1) / 2, t.depth + 1}
+		return n, true
+	}
+	return n, false
+}
+
+// magnitude returns the magnitude of the number, folding each pair into
+// three times its left plus twice its right as soon as both are known.
+func (n number) magnitude() int {
+	stack := make([]token, 0, 16)
+	for _, t := range n {
+		stack = append(stack, t)
+		for top := len(stack); top > 1 && stack[top-1].depth == stack[top-2].depth; top-- {
+			l, r := stack[top-2], stack[top-1]
+			stack[top-2] = token{3*l.value + 2*r.value, l.depth - 1}
+			stack = stack[:top-1]
+		}
+	}
+	return stack[0].value
+}
+
+// sumNumbers returns the reduced sum of the numbers, in order.
+func sumNumbers(ns []number) number {
+	s := append(number(nil), ns[0]...)
+	var buf number
+	for _, n := range ns[1:] {
+		buf = addNumbers(buf, s, n)
+		s, buf = buf, s
+	}
+	return s
+}
+
+// maxNumbersMagnitude returns the highest magnitude of the sum of any two
+// different numbers, splitting the first operands between goroutines.
+func maxNumbersMagnitude(ns []number) int {
+	workers := min(runtime.GOMAXPROCS(0), len(ns))
+	best := make([]int, workers)
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var buf number
+			for i := w; i < len(ns); i += workers {
+				for j := range ns {
+					if i == j {
+						continue
+					}
+					buf = addNumbers(buf, ns[i], ns[j])
+					best[w] = max(best[w], buf.magnitude())
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	m := 0
+	for _, b := range best {
+		m = max(m, b)
+	}
+	return m
+}
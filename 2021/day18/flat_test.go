@@ -0,0 +1,125 @@
+package day18
+
+import (
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/liviro/aoc/internal/parse"
+)
+
+func mustParseNumber(t testing.TB, s string) number {
+	t.Helper()
+	n, err := parseNumber(parse.Span{Text: s, Pos: parse.Pos{Line: 1, Col: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+// randomNumber returns a random snailfish number whose pairs are nested at
+// most four deep, as the homework's are, with regular numbers up to 12 so
+// that some split as soon as they are added.
+func randomNumber(rng *rand.Rand, depth int) string {
+	if depth > 4 || depth > 1 && rng.IntN(3) == 0 {
+		return strconv.Itoa(rng.IntN(13))
+	}
+	return "[" + randomNumber(rng, depth+1) + "," + randomNumber(rng, depth+1) + "]"
+}
+
+func TestParseNumber(t *testing.T) {
+	for _, s := range []string{"[1,2]", "[[1,2],3]", "[9,[8,7]]", "[[1,9],[8,5]]", "[[[[0,7],4],[15,[0,13]]],[1,1]]"} {
+		if got := mustParseNumber(t, s).String(); got != s {
+			t.Errorf("parseNumber(%q).String() = %q", s, got)
+		}
+	}
+	for _, tc := range []struct {
+		s, err string
+	}{
+		{"[1,2", "1:5: unexpected end of number"},
+		{"[1,2]]", `1:6: unexpected ']' after the number`},
+		{"[1;2]", `1:3: unexpected ';'`},
+		{"[1,2,3]", `1:5: unexpected ','`},
+		{"[[1],2]", `1:4: unexpected ']'`},
+		{"7", "1:2: unexpected end of number"},
+	} {
+		_, err := parseNumber(parse.Span{Text: tc.s, Pos: parse.Pos{Line: 1, Col: 1}})
+		if err == nil || err.Error() != tc.err {
+			t.Errorf("parseNumber(%q) = %v, want %q", tc.s, err, tc.err)
+		}
+	}
+}
+
+func TestParseEmpty(t *testing.T) {
+	for _, input := range []string{"", "\n", "  \n"} {
+		s := &solver{}
+		if err := s.Parse(strings.NewReader(input)); err == nil {
+			t.Errorf("Parse(%q) succeeded", input)
+		}
+	}
+}
+
+// TestFlatMatchesPairs checks the flattened numbers against the pair trees,
+// adding random numbers.
+func TestFlatMatchesPairs(t *testing.T) {
+	rng := rand.New(rand.NewPCG(18, 2021))
+	var pairs []*pair
+	var numbers []number
+	for range 30 {
+		s := randomNumber(rng, 1)
+		pairs = append(pairs, strToPair(s))
+		numbers = append(numbers, mustParseNumber(t, s))
+	}
+	var buf number
+	for i := range pairs {
+		for j := range pairs {
+			want := add(pairs[i], pairs[j])
+			buf = addNumbers(buf, numbers[i], numbers[j])
+			if buf.String() != want.String() || buf.magnitude() != want.magnitude() {
+				t.Fatalf("%v + %v = %v (magnitude %d), want %v (magnitude %d)",
+					pairs[i], pairs[j], buf, buf.magnitude(), want, want.magnitude())
+			}
+		}
+	}
+	if got, want := sumNumbers(numbers).String(), sumPairs(pairs).String(); got != want {
+		t.Errorf("sumNumbers() = %s, want %s", got, want)
+	}
+	if got, want := maxNumbersMagnitude(numbers), maxSumMagnitude(pairs); got != want {
+		t.Errorf("maxNumbersMagnitude() = %d, want %d", got, want)
+	}
+}
+
+func TestAddNumbersDoesNotAllocate(t *testing.T) {
+	a := mustParseNumber(t, "[[[0,[5,8]],[[1,7],[9,6]]],[[4,[1,2]],[[1,4],2]]]")
+	b := mustParseNumber(t, "[[[5,[2,8]],4],[5,[[9,9],0]]]")
+	buf := addNumbers(nil, a, b)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = addNumbers(buf, a, b)
+		buf.magnitude()
+	})
+	if allocs != 0 {
+		t.Errorf("addNumbers() and magnitude() allocate %v times", allocs)
+	}
+}
+
+func BenchmarkMaxMagnitude(b *testing.B) {
+	rng := rand.New(rand.NewPCG(18, 2021))
+	var pairs []*pair
+	var numbers []number
+	for range 100 {
+		s := randomNumber(rng, 1)
+		pairs = append(pairs, strToPair(s))
+		numbers = append(numbers, mustParseNumber(b, s))
+	}
+	b.Run("pairs", func(b *testing.B) {
+		for b.Loop() {
+			maxSumMagnitude(pairs)
+		}
+	})
+	b.Run("flat", func(b *testing.B) {
+		for b.Loop() {
+			maxNumbersMagnitude(numbers)
+		}
+	})
+}
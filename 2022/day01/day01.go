@@ -0,0 +1,66 @@
+package day01
+
+import (
+	"io"
+	"sort"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
+)
+
+func parseCarryTotal(rawCals []parse.Span) (int, error) {
+	t := 0
+	for _, c := range rawCals {
+		n, err := c.Int()
+		if err != nil {
+			return 0, err
+		}
+		t += n
+	}
+	return t, nil
+}
+
+func extractInput(r io.Reader) ([]int, error) {
+	rc, err := parse.Sections(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var cs []int
+	for _, c := range rc {
+		t, err := parseCarryTotal(c)
+		if err != nil {
+			return nil, err
+		}
+		cs = append(cs, t)
+	}
+	return cs, nil
+}
+
+func init() {
+	aoc.Register(2022, 1, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	carries []int
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	cs, err := extractInput(r)
+	if err != nil {
+		return err
+	}
+	sort.Ints(cs)
+	s.carries = cs
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	cs := s.carries
+	return aoc.Int(cs[len(cs)-1]), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	cs := s.carries
+	return aoc.Int(cs[len(cs)-1] + cs[len(cs)-2] + cs[len(cs)-3]), nil
+}
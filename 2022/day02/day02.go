@@ -1,9 +1,10 @@
-package main
+package day02
 
 import (
 	"bufio"
-	"fmt"
-	"os"
+	"io"
+
+	"github.com/liviro/aoc/internal/aoc"
 )
 
 const (
@@ -64,37 +65,40 @@ func strToRoundPt2(raw string) round {
 	panic("Unexpected input!")
 }
 
-func extractRounds(fileName string, mode string) ([]round, error) {
-	fp, err := os.Open(fileName)
-	if err != nil {
-		return nil, err
-	}
-	defer fp.Close()
-	s := bufio.NewScanner(fp)
-	var rs []round
+func extractGuide(r io.Reader) ([]string, error) {
+	s := bufio.NewScanner(r)
+	var g []string
 	for s.Scan() {
-		if mode == "part1" {
-			rs = append(rs, strToRoundPt1(s.Text()))
-		} else {
-			rs = append(rs, strToRoundPt2(s.Text()))
-		}
+		g = append(g, s.Text())
 	}
-	return rs, nil
+	return g, s.Err()
 }
 
-func main() {
-	in := "input.txt"
-	rs1, err := extractRounds(in, "part1")
-	if err != nil {
-		fmt.Fprintln(os.Stderr, "Input ingestion went wrong: ", err)
-		os.Exit(1)
+func toRounds(guide []string, strToRound func(string) round) []round {
+	var rs []round
+	for _, raw := range guide {
+		rs = append(rs, strToRound(raw))
 	}
-	fmt.Println("Part 1:", sumScores(rs1))
+	return rs
+}
 
-	rs2, err := extractRounds(in, "part2")
-	if err != nil {
-		fmt.Fprintln(os.Stderr, "Input ingestion went wrong: ", err)
-		os.Exit(1)
-	}
-	fmt.Println("Part 2:", sumScores(rs2))
+func init() {
+	aoc.Register(2022, 2, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	guide []string
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.guide, err = extractGuide(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(sumScores(toRounds(s.guide, strToRoundPt1))), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(sumScores(toRounds(s.guide, strToRoundPt2))), nil
 }
@@ -1,10 +1,11 @@
-package main
+package day03
 
 import (
 	"bufio"
-	"fmt"
-	"os"
+	"io"
 	"strings"
+
+	"github.com/liviro/aoc/internal/aoc"
 )
 
 func findShared(sack string) rune {
@@ -56,13 +57,8 @@ func part2(sacks []string) int {
 	return res
 }
 
-func extractSacks(fileName string) ([]string, error) {
-	fp, err := os.Open(fileName)
-	if err != nil {
-		return nil, err
-	}
-	defer fp.Close()
-	s := bufio.NewScanner(fp)
+func extractSacks(r io.Reader) ([]string, error) {
+	s := bufio.NewScanner(r)
 	var sacks []string
 	for s.Scan() {
 		sacks = append(sacks, s.Text())
@@ -70,13 +66,23 @@ func extractSacks(fileName string) ([]string, error) {
 	return sacks, nil
 }
 
-func main() {
-	in := "input.txt"
-	sacks, err := extractSacks(in)
-	if err != nil {
-		fmt.Fprintln(os.Stderr, "Input ingestion went wrong: ", err)
-		os.Exit(1)
-	}
-	fmt.Println("Part 1:", part1(sacks))
-	fmt.Println("Part 2:", part2(sacks))
+func init() {
+	aoc.Register(2022, 3, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	sacks []string
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.sacks, err = extractSacks(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(part1(s.sacks)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(part2(s.sacks)), nil
 }
@@ -0,0 +1,88 @@
+package day04
+
+import (
+	"io"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
+)
+
+type pairs struct {
+	oneStart int
+	oneEnd   int
+	twoStart int
+	twoEnd   int
+}
+
+// Input format: a-b,c-d
+func parsePair(l parse.Span) (pairs, error) {
+	var p pairs
+	err := parse.Match(l, "%d-%d,%d-%d", &p.oneStart, &p.oneEnd, &p.twoStart, &p.twoEnd)
+	return p, err
+}
+
+func extractPairs(r io.Reader) ([]pairs, error) {
+	ls, err := parse.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+	var ps []pairs
+	for _, l := range ls {
+		p, err := parsePair(l)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, p)
+	}
+	return ps, nil
+}
+
+func (p pairs) isFullyContained() bool {
+	return p.oneStart <= p.twoStart && p.oneEnd >= p.twoEnd || // One fully contains two
+		p.twoStart <= p.oneStart && p.twoEnd >= p.oneEnd // Two fully contins one
+}
+
+func (p pairs) hasOverlap() bool {
+	return !(p.oneEnd < p.twoStart || p.twoEnd < p.oneStart)
+}
+
+func part1(ps []pairs) int {
+	c := 0
+	for _, p := range ps {
+		if p.isFullyContained() {
+			c++
+		}
+	}
+	return c
+}
+
+func part2(ps []pairs) int {
+	c := 0
+	for _, p := range ps {
+		if p.hasOverlap() {
+			c++
+		}
+	}
+	return c
+}
+
+func init() {
+	aoc.Register(2022, 4, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	pairs []pairs
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.pairs, err = extractPairs(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(part1(s.pairs)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(part2(s.pairs)), nil
+}
@@ -1,10 +1,11 @@
-package main
+package day05
 
 import (
-	"fmt"
-	"os"
+	"io"
 	"strconv"
 	"strings"
+
+	"github.com/liviro/aoc/internal/aoc"
 )
 
 type command struct {
@@ -94,22 +95,21 @@ func extractCommands(raw string) ([]*command, error) {
 	return cmds, nil
 }
 
-func extractInput(fileName string) ([]*stack, []*command, error) {
-	raw, err := os.ReadFile(fileName)
+func extractInput(r io.Reader) (string, []*command, error) {
+	raw, err := io.ReadAll(r)
 	if err != nil {
-		return nil, nil, err
+		return "", nil, err
 	}
 
 	rc := strings.Split(string(raw), "\n\n")
-	stacks, err := extractStacks(rc[0])
-	if err != nil {
-		return nil, nil, err
+	if _, err := extractStacks(rc[0]); err != nil {
+		return "", nil, err
 	}
 	cmds, err := extractCommands(rc[1])
 	if err != nil {
-		return nil, nil, err
+		return "", nil, err
 	}
-	return stacks, cmds, nil
+	return rc[0], cmds, nil
 }
 
 func execute1(stacks []*stack, cmd command) {
@@ -142,19 +142,34 @@ func solve(stacks []*stack, cmds []*command, exe func([]*stack, command)) string
 	return s
 }
 
-func main() {
-	in := "input.txt"
-	stacks, cmds, err := extractInput(in)
-	if err != nil {
-		fmt.Fprintln(os.Stderr, "Input ingestion went wrong: ", err)
-		os.Exit(1)
-	}
-	fmt.Println("Part 1:", solve(stacks, cmds, execute1))
+func init() {
+	aoc.Register(2022, 5, func() aoc.Solver { return &solver{} })
+}
+
+// Stacks are moved around in place, so the solver keeps the raw drawing and
+// builds fresh stacks for each part.
+type solver struct {
+	drawing string
+	cmds    []*command
+}
 
-	stacks, cmds, err = extractInput(in)
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.drawing, s.cmds, err = extractInput(r)
+	return err
+}
+
+func (s *solver) solve(exe func([]*stack, command)) (aoc.Answer, error) {
+	stacks, err := extractStacks(s.drawing)
 	if err != nil {
-		fmt.Fprintln(os.Stderr, "Input ingestion went wrong: ", err)
-		os.Exit(1)
+		return aoc.Answer{}, err
 	}
-	fmt.Println("Part 2:", solve(stacks, cmds, execute2))
+	return aoc.Text(solve(stacks, s.cmds, exe)), nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return s.solve(execute1)
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return s.solve(execute2)
 }
@@ -0,0 +1,52 @@
+package day06
+
+import (
+	"io"
+
+	"github.com/liviro/aoc/internal/aoc"
+)
+
+func charsDistinct(s string) bool {
+	m := make(map[rune]struct{})
+	for _, r := range s {
+		if _, ok := m[r]; ok {
+			return false
+		}
+		m[r] = struct{}{}
+	}
+	return true
+}
+
+func markerIndex(buf string, n int) int {
+	for i := n; i < len(buf); i++ {
+		if charsDistinct(buf[i-n : i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+func init() {
+	aoc.Register(2022, 6, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	buf string
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	buf, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	s.buf = string(buf)
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(markerIndex(s.buf, 4)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(markerIndex(s.buf, 14)), nil
+}
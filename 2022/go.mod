@@ -1,3 +0,0 @@
-module github.com/liviro/aoc
-
-go 1.19
@@ -0,0 +1,72 @@
+package day01
+
+import (
+	"io"
+	"math"
+	"slices"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
+)
+
+func extractLists(r io.Reader) ([]int, []int, error) {
+	ls, err := parse.Lines(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	var l1, l2 []int
+	for _, l := range ls {
+		var a, b int
+		if err := parse.Match(l, "%d   %d", &a, &b); err != nil {
+			return nil, nil, err
+		}
+		l1 = append(l1, a)
+		l2 = append(l2, b)
+	}
+	slices.Sort(l1)
+	slices.Sort(l2)
+	return l1, l2, nil
+}
+
+func distance(l1, l2 []int) int {
+	res := 0
+	for i, a := range l1 {
+		res += int(math.Abs(float64(a - l2[i])))
+	}
+	return res
+}
+
+func similarity(l1, l2 []int) int {
+	res := 0
+	d := make(map[int]int)
+	for _, a := range l2 {
+		d[a] += 1
+	}
+	for _, b := range l1 {
+		if c, ok := d[b]; ok {
+			res += b * c
+		}
+	}
+	return res
+}
+
+func init() {
+	aoc.Register(2024, 1, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	l1, l2 []int
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.l1, s.l2, err = extractLists(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(distance(s.l1, s.l2)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(similarity(s.l1, s.l2)), nil
+}
@@ -1,28 +1,22 @@
-package main
+package day02
 
 import (
-	"bufio"
-	"fmt"
-	"os"
-	"strings"
-	"time"
+	"io"
 
-	"github.com/liviro/aoc/2024/internal/parse"
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
 )
 
-func extractReports(name string) ([][]int, error) {
-	fp, err := os.Open(name)
+func extractReports(r io.Reader) ([][]int, error) {
+	ls, err := parse.Lines(r)
 	if err != nil {
 		return nil, err
 	}
-	defer fp.Close()
-	s := bufio.NewScanner(fp)
 	var rs [][]int
-	for s.Scan() {
-		raw := strings.Split(s.Text(), " ")
-		r := []int{}
-		for _, v := range raw {
-			r = append(r, parse.MustInt(v))
+	for _, l := range ls {
+		r, err := parse.Ints(l)
+		if err != nil {
+			return nil, err
 		}
 		rs = append(rs, r)
 	}
@@ -89,13 +83,23 @@ func dampenedOptions(report []int) [][]int {
 	return rs
 }
 
-func main() {
-	t := time.Now()
-	reports, err := extractReports(os.Args[1])
-	if err != nil {
-		fmt.Printf("extractReports: %v", err)
-	}
-	fmt.Printf("Part 1: %d\n", countSafe(reports))
-	fmt.Printf("Part 2: %d\n", countDampenedSafe(reports))
-	fmt.Printf("Time elapsed: %s\n", time.Since(t))
+func init() {
+	aoc.Register(2024, 2, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	reports [][]int
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.reports, err = extractReports(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(countSafe(s.reports)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(countDampenedSafe(s.reports)), nil
 }
@@ -1,22 +1,16 @@
-package main
+package day03
 
 import (
 	"bufio"
-	"fmt"
-	"os"
+	"io"
 	"regexp"
-	"time"
+	"strconv"
 
-	"github.com/liviro/aoc/2024/internal/parse"
+	"github.com/liviro/aoc/internal/aoc"
 )
 
-func extractMem(name string) (string, error) {
-	fp, err := os.Open(name)
-	if err != nil {
-		return "", err
-	}
-	defer fp.Close()
-	s := bufio.NewScanner(fp)
+func extractMem(r io.Reader) (string, error) {
+	s := bufio.NewScanner(r)
 	mem := ""
 	for s.Scan() {
 		mem += s.Text()
@@ -50,19 +44,32 @@ func res(mem string, withToggle bool) int {
 		}
 		// Add to sum, if on
 		if (on || !withToggle) && inst[1] != "" {
-			sum += parse.MustInt(inst[1]) * parse.MustInt(inst[2])
+			// The pattern only matches 1-3 digits, which always convert.
+			a, _ := strconv.Atoi(inst[1])
+			b, _ := strconv.Atoi(inst[2])
+			sum += a * b
 		}
 	}
 	return sum
 }
 
-func main() {
-	t := time.Now()
-	mem, err := extractMem(os.Args[1])
-	if err != nil {
-		fmt.Printf("extractMem: %v", err)
-	}
-	fmt.Printf("Part 1: %d\n", res(mem, false))
-	fmt.Printf("Part 2: %d\n", res(mem, true))
-	fmt.Printf("Time elapsed: %s\n", time.Since(t))
+func init() {
+	aoc.Register(2024, 3, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	mem string
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.mem, err = extractMem(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(res(s.mem, false)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(res(s.mem, true)), nil
 }
@@ -0,0 +1,78 @@
+package day04
+
+import (
+	"io"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/grid"
+)
+
+var (
+	// Part 1: XMAS in a straight line (in any direction: up / down / diagonal)
+	xmasVals    []rune         = []rune{'X', 'M', 'A', 'S'}
+	xmasOffsets [][]grid.Point = [][]grid.Point{
+		{{X: 0, Y: 0}, {X: 0, Y: 1}, {X: 0, Y: 2}, {X: 0, Y: 3}},
+		{{X: 0, Y: 0}, {X: 0, Y: -1}, {X: 0, Y: -2}, {X: 0, Y: -3}},
+		{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 2, Y: 0}, {X: 3, Y: 0}},
+		{{X: 0, Y: 0}, {X: -1, Y: 0}, {X: -2, Y: 0}, {X: -3, Y: 0}},
+		{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 2}, {X: 3, Y: 3}},
+		{{X: 0, Y: 0}, {X: 1, Y: -1}, {X: 2, Y: -2}, {X: 3, Y: -3}},
+		{{X: 0, Y: 0}, {X: -1, Y: 1}, {X: -2, Y: 2}, {X: -3, Y: 3}},
+		{{X: 0, Y: 0}, {X: -1, Y: -1}, {X: -2, Y: -2}, {X: -3, Y: -3}},
+	}
+
+	// Part 2: diagonal MAS crossed with diagonal MAS, either in either direction.
+	crossMasVals    []rune         = []rune{'A', 'M', 'S', 'M', 'S'}
+	crossMasOffsets [][]grid.Point = [][]grid.Point{
+		{{X: 0, Y: 0}, {X: -1, Y: -1}, {X: 1, Y: 1}, {X: 1, Y: -1}, {X: -1, Y: 1}},
+		{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: -1, Y: -1}, {X: 1, Y: -1}, {X: -1, Y: 1}},
+		{{X: 0, Y: 0}, {X: -1, Y: -1}, {X: 1, Y: 1}, {X: -1, Y: 1}, {X: 1, Y: -1}},
+		{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: -1, Y: -1}, {X: -1, Y: 1}, {X: 1, Y: -1}},
+	}
+)
+
+func hasInstance(ws *grid.Grid[rune], start grid.Point, offsets []grid.Point, vals []rune) bool {
+	for i, o := range offsets {
+		if v, ok := ws.Get(start.Add(o)); !ok || v != vals[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func countInstances(ws *grid.Grid[rune], offsets [][]grid.Point, vals []rune) int {
+	c := 0
+	for p := range ws.All() {
+		for _, o := range offsets {
+			if hasInstance(ws, p, o, vals) {
+				c++
+			}
+		}
+	}
+	return c
+}
+
+func extractWordSearch(r io.Reader) (*grid.Grid[rune], error) {
+	return grid.Read(r, grid.Runes)
+}
+
+func init() {
+	aoc.Register(2024, 4, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	ws *grid.Grid[rune]
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.ws, err = extractWordSearch(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(countInstances(s.ws, xmasOffsets, xmasVals)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(countInstances(s.ws, crossMasOffsets, crossMasVals)), nil
+}
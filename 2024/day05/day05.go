@@ -0,0 +1,120 @@
+package day05
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
+)
+
+type rule struct {
+	before, after int
+}
+
+func parseRules(lines []parse.Span) ([]rule, error) {
+	rs := []rule{}
+	for _, l := range lines {
+		var r rule
+		if err := parse.Match(l, "%d|%d", &r.before, &r.after); err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+	}
+	return rs, nil
+}
+
+func parseUpdates(lines []parse.Span) ([][]int, error) {
+	us := [][]int{}
+	for _, l := range lines {
+		u, err := parse.Ints(l)
+		if err != nil {
+			return nil, err
+		}
+		us = append(us, u)
+	}
+	return us, nil
+}
+
+func extractRulesAndUpdates(r io.Reader) ([]rule, [][]int, error) {
+	sections, err := parse.Sections(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(sections) != 2 {
+		return nil, nil, fmt.Errorf("got %d sections, want rules and updates", len(sections))
+	}
+	rs, err := parseRules(sections[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	us, err := parseUpdates(sections[1])
+	if err != nil {
+		return nil, nil, err
+	}
+	return rs, us, nil
+}
+
+// We can safely swap if we see a rule being broken: all previous
+// indexes i will still be before (so same rule) as the following
+// ones.
+func maybeCorrect(update []int, rules []rule) bool {
+	hadUpdate := false
+	for i := range update {
+		for j := i + 1; j < len(update); j++ {
+			if slices.Contains(rules, rule{update[j], update[i]}) {
+				update[i], update[j] = update[j], update[i]
+				hadUpdate = true
+			}
+		}
+	}
+	return hadUpdate
+}
+
+// Updates get corrected in place, so each part works on its own copy.
+func copyUpdates(updates [][]int) [][]int {
+	us := [][]int{}
+	for _, u := range updates {
+		us = append(us, slices.Clone(u))
+	}
+	return us
+}
+
+func sums(updates [][]int, rules []rule) (int, int) {
+	corrects := 0
+	wrongs := 0
+	for _, u := range updates {
+		wasUpdated := maybeCorrect(u, rules)
+		if wasUpdated {
+			wrongs += u[len(u)/2]
+		} else {
+			corrects += u[len(u)/2]
+		}
+	}
+	return corrects, wrongs
+}
+
+func init() {
+	aoc.Register(2024, 5, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	rules   []rule
+	updates [][]int
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.rules, s.updates, err = extractRulesAndUpdates(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	correctSums, _ := sums(copyUpdates(s.updates), s.rules)
+	return aoc.Int(correctSums), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	_, wrongSums := sums(copyUpdates(s.updates), s.rules)
+	return aoc.Int(wrongSums), nil
+}
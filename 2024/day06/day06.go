@@ -0,0 +1,151 @@
+package day06
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/liviro/aoc/internal/anim"
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/grid"
+)
+
+type guard struct {
+	position  grid.Point
+	direction grid.Point
+}
+
+func (g *guard) rotate() {
+	g.direction = g.direction.TurnRight()
+}
+
+func (g guard) nextPosition() grid.Point {
+	return g.position.Add(g.direction)
+}
+
+func (g *guard) move() {
+	g.position = g.nextPosition()
+}
+
+func extractMapData(r io.Reader) (*grid.Grid[bool], *guard, error) {
+	m, err := grid.Read(r, grid.RunesOf(".#^"))
+	if err != nil {
+		return nil, nil, err
+	}
+	start, ok := m.Find(func(v rune) bool { return v == '^' })
+	if !ok {
+		return nil, nil, errors.New("no guard on the map")
+	}
+	obst := grid.Map(m, func(v rune) bool { return v == '#' })
+	return obst, &guard{position: start, direction: grid.Up}, nil
+}
+
+var arrows = map[grid.Point]rune{
+	grid.Up:    '^',
+	grid.Right: '>',
+	grid.Down:  'v',
+	grid.Left:  '<',
+}
+
+// render draws the map with the guard, and the positions it visited as X.
+func render(obst *grid.Grid[bool], g guard, visited map[grid.Point]struct{}) string {
+	return obst.Render(func(p grid.Point, v bool) rune {
+		if _, ok := visited[p]; ok && p != g.position {
+			return 'X'
+		}
+		switch {
+		case p == g.position:
+			return arrows[g.direction]
+		case v:
+			return '#'
+		}
+		return '.'
+	})
+}
+
+func countPositions(obst *grid.Grid[bool], g guard, frames anim.Sink) int {
+	visited := map[grid.Point]struct{}{}
+	// Include starting position.
+	visited[g.position] = struct{}{}
+	for step := 1; ; step++ {
+		anim.Emit(frames, fmt.Sprintf("part 1, step %d", step), func() string { return render(obst, g, visited) })
+		ahead := g.nextPosition()
+		if !obst.In(ahead) {
+			break
+		}
+		if obst.At(ahead) {
+			g.rotate()
+		} else {
+			g.move()
+			visited[g.position] = struct{}{}
+		}
+	}
+	return len(visited)
+}
+
+func hasLoop(obst *grid.Grid[bool], g guard) bool {
+	visited := map[guard]struct{}{}
+	visited[g] = struct{}{}
+	for {
+		ahead := g.nextPosition()
+		if !obst.In(ahead) {
+			break
+		}
+		if obst.At(ahead) {
+			g.rotate()
+		} else {
+			g.move()
+			if _, ok := visited[g]; ok {
+				return true
+			}
+			visited[g] = struct{}{}
+		}
+	}
+	return false
+}
+
+func countLoops(obst *grid.Grid[bool], g guard) int {
+	c := 0
+	obst = obst.Clone()
+	for p, v := range obst.All() {
+		if !v && p != g.position {
+			obst.Set(p, true)
+			if hasLoop(obst, g) {
+				c++
+			}
+			obst.Set(p, false)
+		}
+	}
+	return c
+}
+
+func init() {
+	aoc.Register(2024, 6, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	obst   *grid.Grid[bool]
+	guard  guard
+	frames anim.Sink
+}
+
+func (s *solver) Animate(frames anim.Sink) {
+	s.frames = frames
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	obst, guard, err := extractMapData(r)
+	if err != nil {
+		return err
+	}
+	s.obst, s.guard = obst, *guard
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(countPositions(s.obst, s.guard, s.frames)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(countLoops(s.obst, s.guard)), nil
+}
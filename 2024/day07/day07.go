@@ -1,14 +1,11 @@
-package main
+package day07
 
 import (
-	"bufio"
-	"fmt"
+	"io"
 	"math"
-	"os"
-	"strings"
-	"time"
 
-	"github.com/liviro/aoc/2024/internal/parse"
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
 )
 
 type equation struct {
@@ -16,30 +13,42 @@ type equation struct {
 	nums    []int
 }
 
-func extractEquations(name string) []equation {
-	fp, err := os.Open(name)
+func extractEquations(r io.Reader) ([]equation, error) {
+	ls, err := parse.Lines(r)
 	if err != nil {
-		panic("Unable to open file")
+		return nil, err
 	}
-	defer fp.Close()
-	s := bufio.NewScanner(fp)
 	eqs := []equation{}
-	for s.Scan() {
-		r := strings.Split(s.Text(), ": ")
-		e := equation{
-			testVal: parse.MustInt(r[0]),
-			nums:    []int{},
+	for _, l := range ls {
+		ps := parse.Split(l, ": ")
+		if len(ps) != 2 {
+			return nil, l.Errorf("expected test value and numbers separated by %q", ": ")
 		}
-		for _, n := range strings.Split(r[1], " ") {
-			e.nums = append(e.nums, parse.MustInt(n))
+		e := equation{}
+		if e.testVal, err = ps[0].Int(); err != nil {
+			return nil, err
+		}
+		for _, f := range parse.Fields(ps[1]) {
+			n, err := f.Int()
+			if err != nil {
+				return nil, err
+			}
+			e.nums = append(e.nums, n)
 		}
 		eqs = append(eqs, e)
 	}
-	return eqs
+	return eqs, nil
 }
 
+// concat returns the number whose digits are those of a followed by those of b.
 func concat(a, b int) int {
-	return parse.MustInt(fmt.Sprintf("%d%d", a, b))
+	for m := b; ; m /= 10 {
+		a *= 10
+		if m < 10 {
+			break
+		}
+	}
+	return a + b
 }
 
 func digits(a int) int {
@@ -93,10 +102,23 @@ func sumPossible(eqs []equation, withConcat bool) int {
 	return s
 }
 
-func main() {
-	t := time.Now()
-	eqs := extractEquations(os.Args[1])
-	fmt.Printf("Part 1: %d\n", sumPossible(eqs, false))
-	fmt.Printf("Part 2: %d\n", sumPossible(eqs, true))
-	fmt.Printf("Time elapsed: %s\n", time.Since(t))
+func init() {
+	aoc.Register(2024, 7, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	eqs []equation
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.eqs, err = extractEquations(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(sumPossible(s.eqs, false)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(sumPossible(s.eqs, true)), nil
 }
@@ -0,0 +1,85 @@
+package day08
+
+import (
+	"io"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/grid"
+)
+
+// Extract the coordinates per frequency, along with the map they're on.
+func extractMapData(r io.Reader) (map[rune][]grid.Point, *grid.Grid[rune], error) {
+	m, err := grid.Read(r, grid.Runes)
+	if err != nil {
+		return nil, nil, err
+	}
+	freqs := map[rune][]grid.Point{}
+	for p, v := range m.All() {
+		if v != '.' {
+			freqs[v] = append(freqs[v], p)
+		}
+	}
+	return freqs, m, nil
+}
+
+func countAntinodes(freqs map[rune][]grid.Point, m *grid.Grid[rune]) int {
+	ns := map[grid.Point]struct{}{}
+	for _, cs := range freqs {
+		for i, a := range cs {
+			for j := i + 1; j < len(cs); j++ {
+				b := cs[j]
+				n1 := a.Mul(2).Sub(b)
+				if m.In(n1) {
+					ns[n1] = struct{}{}
+				}
+				n2 := b.Mul(2).Sub(a)
+				if m.In(n2) {
+					ns[n2] = struct{}{}
+				}
+			}
+		}
+	}
+	return len(ns)
+}
+func countAntinodesWithHarmonics(freqs map[rune][]grid.Point, m *grid.Grid[rune]) int {
+	ns := map[grid.Point]struct{}{}
+	for _, cs := range freqs {
+		for i, a := range cs {
+			for j := i + 1; j < len(cs); j++ {
+				b := cs[j]
+				delta := b.Sub(a)
+				// Go back (includes node a)
+				for nn := a; m.In(nn); nn = nn.Sub(delta) {
+					ns[nn] = struct{}{}
+				}
+				// Go forward (includes node b)
+				for nn := b; m.In(nn); nn = nn.Add(delta) {
+					ns[nn] = struct{}{}
+				}
+			}
+		}
+	}
+	return len(ns)
+}
+
+func init() {
+	aoc.Register(2024, 8, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	freqs map[rune][]grid.Point
+	m     *grid.Grid[rune]
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.freqs, s.m, err = extractMapData(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(countAntinodes(s.freqs, s.m)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(countAntinodesWithHarmonics(s.freqs, s.m)), nil
+}
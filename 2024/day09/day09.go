@@ -1,33 +1,31 @@
-package main
+package day09
 
 import (
-	"bufio"
-	"fmt"
-	"os"
+	"io"
 	"sort"
-	"time"
 
-	"github.com/liviro/aoc/2024/internal/parse"
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
 )
 
 type block struct {
 	idx, len int
 }
 
-func extractDiskMap(name string) []int {
-	fp, err := os.Open(name)
+func extractDiskMap(r io.Reader) ([]int, error) {
+	ls, err := parse.Lines(r)
 	if err != nil {
-		panic("Unable to open file")
+		return nil, err
 	}
-	defer fp.Close()
-	s := bufio.NewScanner(fp)
 	res := []int{}
-	for s.Scan() {
-		for _, v := range s.Text() {
-			res = append(res, parse.MustInt(string(v)))
+	for _, l := range ls {
+		ds, err := parse.Digits(l)
+		if err != nil {
+			return nil, err
 		}
+		res = append(res, ds...)
 	}
-	return res
+	return res, nil
 }
 
 func individualCheckSum(diskMap []int) int {
@@ -168,10 +166,23 @@ func blockCheckSum(diskMap []int) int {
 	return cs
 }
 
-func main() {
-	t := time.Now()
-	dm := extractDiskMap(os.Args[1])
-	fmt.Printf("Part 1: %d\n", individualCheckSum(dm))
-	fmt.Printf("Part 2: %d\n", blockCheckSum(dm))
-	fmt.Printf("Time elapsed: %s\n", time.Since(t))
+func init() {
+	aoc.Register(2024, 9, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	dm []int
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.dm, err = extractDiskMap(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(individualCheckSum(s.dm)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(blockCheckSum(s.dm)), nil
 }
@@ -0,0 +1,81 @@
+package day10
+
+import (
+	"io"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/grid"
+)
+
+type pos struct {
+	height        int
+	reachableTops map[grid.Point]struct{}
+	score         int
+}
+
+func extractMap(r io.Reader) (*grid.Grid[int], error) {
+	return grid.Read(r, grid.Digit)
+}
+
+// Scoring fills in the positions, so each scoring gets fresh ones.
+func newMap(heights *grid.Grid[int]) *grid.Grid[*pos] {
+	return grid.Map(heights, func(h int) *pos {
+		return &pos{
+			height:        h,
+			reachableTops: map[grid.Point]struct{}{},
+		}
+	})
+}
+
+// Returns sum of scores and sums of ratings of trailheads
+func scoreTrailheads(m *grid.Grid[*pos]) (int, int) {
+	score := 0
+	rating := 0
+	for h := 9; h >= 0; h-- {
+		for p, v := range m.All() {
+			if v.height == h {
+				// Special-case tops, they're their own peaks.
+				if h == 9 {
+					v.reachableTops[p] = struct{}{}
+					v.score = 1
+				}
+				for _, a := range m.Neighbors4(p) {
+					if n := m.At(a); n.height == h+1 {
+						for t := range n.reachableTops {
+							v.reachableTops[t] = struct{}{}
+						}
+						v.score += n.score
+					}
+				}
+				if h == 0 {
+					score += len(v.reachableTops)
+					rating += v.score
+				}
+			}
+		}
+	}
+	return score, rating
+}
+
+func init() {
+	aoc.Register(2024, 10, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	heights *grid.Grid[int]
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.heights, err = extractMap(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	scores, _ := scoreTrailheads(newMap(s.heights))
+	return aoc.Int(scores), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	_, ratings := scoreTrailheads(newMap(s.heights))
+	return aoc.Int(ratings), nil
+}
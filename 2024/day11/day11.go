@@ -1,29 +1,30 @@
-package main
+package day11
 
 import (
-	"bufio"
 	"fmt"
-	"os"
-	"strings"
-	"time"
+	"io"
+	"math"
 
-	"github.com/liviro/aoc/2024/internal/parse"
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
 )
 
-func extractStones(name string) []int {
-	fp, err := os.Open(name)
+func extractStones(r io.Reader) ([]int, error) {
+	ls, err := parse.Lines(r)
 	if err != nil {
-		panic("Unable to open file")
+		return nil, err
 	}
-	defer fp.Close()
-	s := bufio.NewScanner(fp)
 	res := []int{}
-	for s.Scan() {
-		for _, r := range strings.Split(s.Text(), " ") {
-			res = append(res, parse.MustInt(r))
+	for _, l := range ls {
+		for _, f := range parse.Fields(l) {
+			n, err := f.Int()
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, n)
 		}
 	}
-	return res
+	return res, nil
 }
 
 // In a memoized way, return the number of stones that the input stone S
@@ -55,9 +56,9 @@ func evolveStones(s int, blinks int, memo map[int]map[int]int) int {
 	}
 	// Even digits -> split into 2 stones
 	if len(fmt.Sprintf("%d", s))%2 == 0 {
-		orig := fmt.Sprintf("%d", s)
-		s1 := parse.MustInt(orig[:len(orig)/2])
-		s2 := parse.MustInt(orig[len(orig)/2:])
+		half := int(math.Pow10(len(fmt.Sprintf("%d", s)) / 2))
+		s1 := s / half
+		s2 := s % half
 		c1 := evolveStones(s1, blinks-1, memo)
 		c2 := evolveStones(s2, blinks-1, memo)
 		memo[s][blinks] = c1 + c2
@@ -78,10 +79,23 @@ func countStones(stones []int, blinks int) int {
 	return sum
 }
 
-func main() {
-	t := time.Now()
-	stones := extractStones(os.Args[1])
-	fmt.Printf("Part 1: %d\n", countStones(stones, 25))
-	fmt.Printf("Part 2: %d\n", countStones(stones, 75))
-	fmt.Printf("Time elapsed: %s\n", time.Since(t))
+func init() {
+	aoc.Register(2024, 11, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	stones []int
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.stones, err = extractStones(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(countStones(s.stones, 25)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(countStones(s.stones, 75)), nil
 }
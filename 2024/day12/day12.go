@@ -0,0 +1,146 @@
+package day12
+
+import (
+	"io"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/grid"
+)
+
+type plot struct {
+	plant   rune
+	visited bool
+}
+
+func extractMap(r io.Reader) (*grid.Grid[rune], error) {
+	return grid.Read(r, grid.Runes)
+}
+
+// Pricing marks plots as visited, so each pricing gets fresh ones.
+func newMap(plants *grid.Grid[rune]) *grid.Grid[*plot] {
+	return grid.Map(plants, func(v rune) *plot {
+		return &plot{
+			plant:   v,
+			visited: false,
+		}
+	})
+}
+
+// Mutates plot to note what has been visited, returns the
+// (perimeter, area, sides) of the region that contains the starting
+// index.
+func processRegion(m *grid.Grid[*plot], start grid.Point) (int, int, int) {
+	max := grid.Point{X: m.W - 1, Y: m.H - 1}
+	tbc := map[grid.Point]struct{}{}
+	done := map[grid.Point]struct{}{}
+	tbc[start] = struct{}{}
+	area := 0
+	perimeter := 0
+	for {
+		if len(tbc) == 0 {
+			break
+		}
+		for p := range tbc {
+			area++
+			regionAdjs := 0
+			adjs := m.Neighbors4(p)
+			for _, a := range adjs {
+				if m.At(a).plant == m.At(p).plant {
+					regionAdjs++
+					if _, ok := done[a]; !ok {
+						tbc[a] = struct{}{}
+					}
+				}
+			}
+			perimeter += (4 - regionAdjs)
+			m.At(p).visited = true
+			done[p] = struct{}{}
+			delete(tbc, p)
+		}
+
+	}
+	sides := countSides(done, max)
+	return perimeter, area, sides
+}
+
+func countSides(region map[grid.Point]struct{}, max grid.Point) int {
+	s := 0
+	// Count horizontal sides
+	for i := 0; i <= max.Y+1; i++ {
+		isOn := false
+		isAbove := false
+		for j := 0; j <= max.X; j++ {
+			nextAbove := i != 0 && isIn(region, grid.Point{X: j, Y: i - 1})
+			nextBelow := i != max.Y+1 && isIn(region, grid.Point{X: j, Y: i})
+			nextOn := nextAbove != nextBelow
+			// New side is starting, either:
+			// - Was previously off, is now on
+			// - Region side flipped from above to below (or vice versa)
+			if (nextOn && !isOn) || (nextOn && isOn && isAbove != nextAbove) {
+				s++
+			}
+			isOn = nextOn
+			isAbove = nextAbove
+		}
+	}
+	// Count vertical sides
+	for j := 0; j <= max.X+1; j++ {
+		isOn := false
+		isLeft := false
+		for i := 0; i <= max.Y; i++ {
+			nextLeft := j != 0 && isIn(region, grid.Point{X: j - 1, Y: i})
+			nextRight := j != max.X+1 && isIn(region, grid.Point{X: j, Y: i})
+			nextOn := nextLeft != nextRight
+			// New side is starting, either:
+			// - Was previously off, is now on
+			// - Region side flipped from left to right (or vice versa)
+			if (nextOn && !isOn) || (nextOn && isOn && isLeft != nextLeft) {
+				s++
+			}
+			isOn = nextOn
+			isLeft = nextLeft
+		}
+	}
+	return s
+}
+
+func isIn(region map[grid.Point]struct{}, c grid.Point) bool {
+	_, ok := region[c]
+	return ok
+}
+
+func totalPrices(m *grid.Grid[*plot]) (int, int) {
+	part1 := 0
+	part2 := 0
+	for c, v := range m.All() {
+		if !v.visited {
+			p, a, s := processRegion(m, c)
+			part1 += p * a
+			part2 += s * a
+		}
+	}
+	return part1, part2
+}
+
+func init() {
+	aoc.Register(2024, 12, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	plants *grid.Grid[rune]
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.plants, err = extractMap(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	p1, _ := totalPrices(newMap(s.plants))
+	return aoc.Int(p1), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	_, p2 := totalPrices(newMap(s.plants))
+	return aoc.Int(p2), nil
+}
@@ -0,0 +1,110 @@
+package day13
+
+import (
+	"io"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
+)
+
+type button struct {
+	x, y int
+}
+
+type machine struct {
+	a, b button
+	x, y int
+}
+
+func extractMachines(r io.Reader) ([]machine, error) {
+	sections, err := parse.Sections(r)
+	if err != nil {
+		return nil, err
+	}
+	ms := []machine{}
+	for _, rows := range sections {
+		m, err := parseMachine(rows)
+		if err != nil {
+			return nil, err
+		}
+		ms = append(ms, m)
+	}
+	return ms, nil
+}
+
+func parseMachine(rows []parse.Span) (machine, error) {
+	m := machine{
+		a: button{},
+		b: button{},
+	}
+	if len(rows) != 3 {
+		return m, rows[0].Errorf("machine has %d rows, want 3", len(rows))
+	}
+	if err := parse.Match(rows[0], "Button A: X+%d, Y+%d", &m.a.x, &m.a.y); err != nil {
+		return m, err
+	}
+	if err := parse.Match(rows[1], "Button B: X+%d, Y+%d", &m.b.x, &m.b.y); err != nil {
+		return m, err
+	}
+	if err := parse.Match(rows[2], "Prize: X=%d, Y=%d", &m.x, &m.y); err != nil {
+		return m, err
+	}
+	return m, nil
+}
+
+func fixMachines(ms []machine) []machine {
+	fms := []machine{}
+	for _, m := range ms {
+		fms = append(fms, machine{
+			a: m.a, b: m.b,
+			x: 10000000000000 + m.x,
+			y: 10000000000000 + m.y,
+		})
+	}
+	return fms
+}
+
+func tokens(m machine) int {
+	dn := m.a.x*m.b.y - m.a.y*m.b.x
+	in := (m.x*m.b.y - m.y*m.b.x)
+	jn := (m.y*m.a.x - m.x*m.a.y)
+	if in%dn != 0 || jn%dn != 0 {
+		return -1
+	}
+	if in*dn <= 0 || jn*dn <= 0 {
+		return -1
+	}
+	return 3*(in/dn) + (jn / dn)
+}
+
+func countTokens(ms []machine) int {
+	s := 0
+	for _, m := range ms {
+		mt := tokens(m)
+		if mt != -1 {
+			s += mt
+		}
+	}
+	return s
+}
+
+func init() {
+	aoc.Register(2024, 13, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	ms []machine
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.ms, err = extractMachines(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(countTokens(s.ms)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(countTokens(fixMachines(s.ms))), nil
+}
@@ -0,0 +1,245 @@
+package day14
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/liviro/aoc/internal/anim"
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/grid"
+	"github.com/liviro/aoc/internal/parse"
+)
+
+type coord struct {
+	x, y int
+}
+
+type robot struct {
+	pos coord
+	vel coord
+}
+
+func (r robot) String() string {
+	return fmt.Sprintf("pos: (%d, %d), vel: (%d, %d)", r.pos.x, r.pos.y, r.vel.x, r.vel.y)
+}
+
+// room is the area the robots move in, wrapping around its edges.
+type room struct {
+	width, height int
+}
+
+// wrap returns p + v·t, wrapped around into [0, size), for any tick t, even a
+// huge or negative one: t is first reduced modulo size, as the robot is back
+// where it was every size ticks.
+func wrap(p, v, t, size int) int {
+	return ((p+v*(t%size))%size + size) % size
+}
+
+// at returns where the robot is after t ticks.
+func (rm room) at(r robot, t int) coord {
+	return coord{
+		x: wrap(r.pos.x, r.vel.x, t, rm.width),
+		y: wrap(r.pos.y, r.vel.y, t, rm.height),
+	}
+}
+
+// positionsAt returns where the robots are after t ticks.
+func (rm room) positionsAt(rs []robot, t int) []coord {
+	ps := make([]coord, len(rs))
+	for i, r := range rs {
+		ps[i] = rm.at(r, t)
+	}
+	return ps
+}
+
+func extractRobots(r io.Reader) ([]robot, error) {
+	ls, err := parse.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+	rs := []robot{}
+	for _, l := range ls {
+		r := robot{}
+		if err := parse.Match(l, "p=%d,%d v=%d,%d", &r.pos.x, &r.pos.y, &r.vel.x, &r.vel.y); err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+	}
+	return rs, nil
+}
+
+// quadrants counts the robots in each quadrant of the room: top left, top
+// right, bottom left and bottom right. Those on the middle lines are in none.
+func (rm room) quadrants(ps []coord) [4]int {
+	midX, midY := (rm.width-1)/2, (rm.height-1)/2
+	var qs [4]int
+	for _, p := range ps {
+		switch {
+		case p.x < midX && p.y < midY:
+			qs[0]++
+		case p.x > midX && p.y < midY:
+			qs[1]++
+		case p.x < midX && p.y > midY:
+			qs[2]++
+		case p.x > midX && p.y > midY:
+			qs[3]++
+		}
+	}
+	return qs
+}
+
+func (rm room) safetyFactor(ps []coord) int {
+	qs := rm.quadrants(ps)
+	return qs[0] * qs[1] * qs[2] * qs[3]
+}
+
+func (rm room) display(ps []coord) string {
+	dis := [][]int{}
+	for i := 0; i < rm.height; i++ {
+		row := slices.Repeat([]int{0}, rm.width)
+		dis = append(dis, row)
+	}
+	for _, p := range ps {
+		dis[p.y][p.x]++
+	}
+	var b strings.Builder
+	for _, r := range dis {
+		for _, v := range r {
+			if v == 0 {
+				b.WriteString(".")
+			} else {
+				b.WriteString(fmt.Sprintf("%d", v))
+			}
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func part1(rm room, rs []robot, frames anim.Sink) int {
+	for t := 1; t <= 100; t++ {
+		anim.Emit(frames, fmt.Sprintf("part 1, tick %d", t), func() string { return rm.display(rm.positionsAt(rs, t)) })
+	}
+	return rm.safetyFactor(rm.positionsAt(rs, 100))
+}
+
+// spread returns how spread out the coordinates of the robots along one axis
+// are: n² times their variance, kept in integers.
+func spread(ps []coord, axis func(coord) int) int {
+	sum, squares := 0, 0
+	for _, p := range ps {
+		c := axis(p)
+		sum += c
+		squares += c * c
+	}
+	return len(ps)*squares - sum*sum
+}
+
+// crt returns the smallest t ≥ 0 with t ≡ a (mod m) and t ≡ b (mod n), and
+// whether there is one, by the Chinese remainder theorem.
+func crt(a, m, b, n int) (int, bool) {
+	// Solve a + m·k ≡ b (mod n), with m·x ≡ g (mod n) from Euclid.
+	g, x := m, 1
+	for r, y := n, 0; r != 0; {
+		q := g / r
+		g, r = r, g-q*r
+		x, y = y, x-q*y
+	}
+	if (b-a)%g != 0 {
+		return 0, false
+	}
+	l := m / g * n
+	k := (b - a) / g * x % (n / g)
+	return ((a+m*k)%l + l) % l, true
+}
+
+// part2 finds the tick at which the robots draw a picture, as the one where
+// they are the least spread out along both axes. The x coordinates repeat
+// every width ticks, and the y ones every height ticks, so the best of each
+// period is found separately, and the two are combined by the Chinese
+// remainder theorem.
+func part2(rm room, rs []robot, frames anim.Sink) (int, error) {
+	bestX, bestY := 0, 0
+	minX, minY := math.MaxInt, math.MaxInt
+	for t := range max(rm.width, rm.height) {
+		ps := rm.positionsAt(rs, t)
+		if sx := spread(ps, func(p coord) int { return p.x }); t < rm.width && sx < minX {
+			bestX, minX = t, sx
+		}
+		if sy := spread(ps, func(p coord) int { return p.y }); t < rm.height && sy < minY {
+			bestY, minY = t, sy
+		}
+		anim.Emit(frames, fmt.Sprintf("part 2, tick %d", t), func() string { return rm.display(ps) })
+	}
+	tick, ok := crt(bestX, rm.width, bestY, rm.height)
+	if !ok {
+		return 0, fmt.Errorf("no tick is %d modulo %d and %d modulo %d", bestX, rm.width, bestY, rm.height)
+	}
+	anim.Emit(frames, fmt.Sprintf("part 2, tick %d: the picture", tick), func() string {
+		return rm.display(rm.positionsAt(rs, tick))
+	})
+	return tick, nil
+}
+
+func init() {
+	aoc.Register(2024, 14, func() aoc.Solver {
+		return &solver{room: room{width: 101, height: 103}}
+	})
+}
+
+type solver struct {
+	room   room
+	rs     []robot
+	frames anim.Sink
+}
+
+// PositionsAt returns where the robots are after t ticks, which may be
+// arbitrarily large, or negative to look back in time, in the order they were
+// listed.
+func (s *solver) PositionsAt(t int) []grid.Point {
+	ps := make([]grid.Point, len(s.rs))
+	for i, p := range s.room.positionsAt(s.rs, t) {
+		ps[i] = grid.Point{X: p.x, Y: p.y}
+	}
+	return ps
+}
+
+// SafetyFactorAt returns the safety factor of the robots after t ticks.
+func (s *solver) SafetyFactorAt(t int) int {
+	return s.room.safetyFactor(s.room.positionsAt(s.rs, t))
+}
+
+func (s *solver) Animate(frames anim.Sink) {
+	s.frames = frames
+}
+
+func (s *solver) Params() []aoc.Param {
+	return []aoc.Param{
+		{Name: "width", Usage: "width of the room", Value: &s.room.width},
+		{Name: "height", Usage: "height of the room", Value: &s.room.height},
+	}
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	// Positions wrap around modulo the size of the room.
+	if s.room.width <= 0 || s.room.height <= 0 {
+		return fmt.Errorf("room of %dx%d, want a positive width and height", s.room.width, s.room.height)
+	}
+	s.rs, err = extractRobots(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(part1(s.room, s.rs, s.frames)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	tick, err := part2(s.room, s.rs, s.frames)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Int(tick), nil
+}
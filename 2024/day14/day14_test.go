@@ -0,0 +1,147 @@
+package day14
+
+import (
+	"math/rand/v2"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/liviro/aoc/internal/grid"
+)
+
+// move moves the robot one tick on, the stepwise way positions are checked
+// against.
+func (rm room) move(r *robot) {
+	r.pos = coord{
+		x: (r.pos.x + r.vel.x + rm.width) % rm.width,
+		y: (r.pos.y + r.vel.y + rm.height) % rm.height,
+	}
+}
+
+func example(t *testing.T) *solver {
+	t.Helper()
+	f, err := os.Open("input-test.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	s := &solver{room: room{width: 11, height: 7}}
+	if err := s.Parse(f); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestPositionsAt(t *testing.T) {
+	s := example(t)
+	forward := slices.Clone(s.rs)
+	backward := slices.Clone(s.rs)
+	for i := range backward {
+		backward[i].vel = coord{-backward[i].vel.x, -backward[i].vel.y}
+	}
+	for tick := range 200 {
+		for _, ticks := range []struct {
+			t  int
+			rs []robot
+		}{{tick, forward}, {-tick, backward}} {
+			got := s.PositionsAt(ticks.t)
+			for i, r := range ticks.rs {
+				if want := (grid.Point{X: r.pos.x, Y: r.pos.y}); got[i] != want {
+					t.Fatalf("robot %d at tick %d: got %v, want %v", i, ticks.t, got[i], want)
+				}
+			}
+		}
+		for i := range forward {
+			s.room.move(&forward[i])
+			s.room.move(&backward[i])
+		}
+	}
+}
+
+func TestSafetyFactorAt(t *testing.T) {
+	s := example(t)
+	if got := s.SafetyFactorAt(100); got != 12 {
+		t.Errorf("SafetyFactorAt(100) = %d, want 12", got)
+	}
+	// Every robot is back where it started every 77 ticks.
+	for _, tick := range []int{1e12, -1e12, 1<<62 + 5} {
+		steps := (tick%77 + 77) % 77
+		rs := slices.Clone(s.rs)
+		for range steps {
+			for i := range rs {
+				s.room.move(&rs[i])
+			}
+		}
+		var ps []coord
+		for _, r := range rs {
+			ps = append(ps, r.pos)
+		}
+		if got, want := s.SafetyFactorAt(tick), s.room.safetyFactor(ps); got != want {
+			t.Errorf("SafetyFactorAt(%d) = %d, want %d as after %d steps", tick, got, want, steps)
+		}
+	}
+}
+
+func TestCRT(t *testing.T) {
+	for m := 1; m < 20; m++ {
+		for n := 1; n < 20; n++ {
+			for a := range m {
+				for b := range n {
+					want, found := 0, false
+					for ; want < m*n; want++ {
+						if want%m == a && want%n == b {
+							found = true
+							break
+						}
+					}
+					got, ok := crt(a, m, b, n)
+					if ok != found || (ok && got != want) {
+						t.Fatalf("crt(%d, %d, %d, %d) = %d, %v, want %d, %v", a, m, b, n, got, ok, want, found)
+					}
+				}
+			}
+		}
+	}
+}
+
+// picture returns robots which gather into a small square at the given tick,
+// among others wandering about.
+func picture(rng *rand.Rand, rm room, tick int) []robot {
+	var rs []robot
+	for i := range 400 {
+		r := robot{vel: coord{rng.IntN(201) - 100, rng.IntN(201) - 100}}
+		if i < 200 {
+			// At the tick, in the square between (40, 40) and (59, 59).
+			at := coord{40 + rng.IntN(20), 40 + rng.IntN(20)}
+			r.pos = coord{
+				x: ((at.x-r.vel.x*tick)%rm.width + rm.width) % rm.width,
+				y: ((at.y-r.vel.y*tick)%rm.height + rm.height) % rm.height,
+			}
+		} else {
+			r.pos = coord{rng.IntN(rm.width), rng.IntN(rm.height)}
+		}
+		rs = append(rs, r)
+	}
+	return rs
+}
+
+func TestPart2(t *testing.T) {
+	rng := rand.New(rand.NewPCG(14, 2024))
+	rm := room{width: 101, height: 103}
+	for _, tick := range []int{0, 1, 879, 8050, 101*103 - 1} {
+		got, err := part2(rm, picture(rng, rm, tick), nil)
+		if err != nil || got != tick {
+			t.Errorf("part2() = %d, %v, want %d", got, err, tick)
+		}
+	}
+}
+
+func TestEmptyRoom(t *testing.T) {
+	for _, rm := range []room{{0, 7}, {11, -1}} {
+		s := &solver{room: rm}
+		if err := s.Parse(strings.NewReader("p=0,4 v=3,-3\n")); err == nil {
+			t.Errorf("Parse() in a room of %dx%d succeeded", rm.width, rm.height)
+		}
+	}
+}
@@ -0,0 +1,286 @@
+package day15
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/liviro/aoc/internal/anim"
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/grid"
+	"github.com/liviro/aoc/internal/parse"
+)
+
+// 0: nothing
+// 1: wall
+// 2: box (or left side of wide box)
+// 3: right side of wide box
+type warehouse = *grid.Grid[int]
+
+// cellRune returns how a cell of the warehouse is drawn.
+func cellRune(v int, isWide bool) rune {
+	switch {
+	case v == 1:
+		return '#'
+	case v == 2 && isWide:
+		return '['
+	case v == 2:
+		return 'O'
+	case v == 3:
+		return ']'
+	}
+	return '.'
+}
+
+func printMap(wh warehouse, robot grid.Point, isWide bool) string {
+	return wh.Render(func(p grid.Point, v int) rune {
+		if p == robot {
+			return '@'
+		}
+		return cellRune(v, isWide)
+	})
+}
+
+func extractWarehouse(m *grid.Grid[rune]) (warehouse, grid.Point) {
+	var robot grid.Point
+	wh := grid.New[int](m.W, m.H)
+	for p, v := range m.All() {
+		switch v {
+		case '#':
+			wh.Set(p, 1)
+		case 'O':
+			wh.Set(p, 2)
+		case '@':
+			robot = p
+		}
+	}
+	return wh, robot
+}
+
+func extractWideWarehouse(m *grid.Grid[rune]) (warehouse, grid.Point) {
+	var robot grid.Point
+	wh := grid.New[int](2*m.W, m.H)
+	for p, v := range m.All() {
+		left := grid.Point{X: 2 * p.X, Y: p.Y}
+		right := left.Add(grid.Right)
+		switch v {
+		case '#':
+			wh.Set(left, 1)
+			wh.Set(right, 1)
+		case 'O':
+			wh.Set(left, 2)
+			wh.Set(right, 3)
+		case '@':
+			robot = left
+		}
+	}
+	return wh, robot
+}
+
+var moves = map[rune]grid.Point{
+	'^': grid.Up,
+	'>': grid.Right,
+	'v': grid.Down,
+	'<': grid.Left,
+}
+
+func extractMoves(lines []parse.Span) ([]grid.Point, error) {
+	ms := []grid.Point{}
+	for _, l := range lines {
+		for i, m := range l.Text {
+			d, ok := moves[m]
+			if !ok {
+				return nil, l.Slice(i, i+1).Errorf("unknown move %q", m)
+			}
+			ms = append(ms, d)
+		}
+	}
+	return ms, nil
+}
+
+// The warehouse gets rearranged by the moves, so only its map is kept
+// around, to be extracted afresh for each part.
+func extractInput(r io.Reader) (*grid.Grid[rune], []grid.Point, error) {
+	sections, err := parse.Sections(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(sections) != 2 {
+		return nil, nil, fmt.Errorf("got %d sections, want map and moves", len(sections))
+	}
+	m, err := grid.Parse(sections[0], grid.RunesOf(".#O@"))
+	if err != nil {
+		return nil, nil, err
+	}
+	robots := 0
+	for _, l := range sections[0] {
+		for i, c := range l.Text {
+			if c != '@' {
+				continue
+			}
+			if robots++; robots > 1 {
+				return nil, nil, l.Slice(i, i+1).Errorf("another robot")
+			}
+		}
+	}
+	if robots == 0 {
+		return nil, nil, errors.New("no robot in the warehouse")
+	}
+	ms, err := extractMoves(sections[1])
+	if err != nil {
+		return nil, nil, err
+	}
+	return m, ms, nil
+}
+
+// cell returns what is at the point of the warehouse, taking anything off its
+// edge for a wall.
+func cell(wh warehouse, p grid.Point) int {
+	if v, ok := wh.Get(p); ok {
+		return v
+	}
+	return 1
+}
+
+func attemptMove(wh warehouse, robot grid.Point, move grid.Point) step {
+	st := step{move: move, from: robot, to: robot}
+	stack := []int{}
+	next := robot.Add(move)
+S:
+	for {
+		v := cell(wh, next)
+		// Bumped wall: abort and do nothing
+		if v == 1 {
+			return st
+		}
+		// Empty space: stop stacking
+		if v == 0 {
+			break S
+		}
+		// Box: add to stack
+		if v == 2 || v == 3 {
+			stack = append(stack, v)
+		}
+		next = next.Add(move)
+	}
+	for s := len(stack) - 1; s >= 0; s-- {
+		st.set(wh, next, stack[s])
+		next = next.Sub(move)
+	}
+	st.to = robot.Add(move)
+	st.set(wh, st.to, 0)
+	return st
+}
+
+func attemptWideMove(wh warehouse, robot grid.Point, move grid.Point) step {
+	// Can use old move attempter
+	if move.Y == 0 {
+		return attemptMove(wh, robot, move)
+	}
+	st := step{move: move, from: robot, to: robot}
+	init := map[grid.Point]struct{}{}
+	init[robot] = struct{}{}
+	stack := []map[grid.Point]struct{}{init}
+S:
+	for {
+		toCheck := stack[len(stack)-1]
+		nextStack := map[grid.Point]struct{}{}
+		for s := range toCheck {
+			ahead := s.Add(move)
+			switch cell(wh, ahead) {
+			// Bumped wall: abort and do nothing
+			case 1:
+				return st
+			case 2:
+				nextStack[ahead] = struct{}{}
+				nextStack[ahead.Add(grid.Right)] = struct{}{}
+			case 3:
+				nextStack[ahead.Add(grid.Left)] = struct{}{}
+				nextStack[ahead] = struct{}{}
+			}
+		}
+		// All empty space detected: stop stacking
+		if len(nextStack) == 0 {
+			break S
+		}
+		stack = append(stack, nextStack)
+	}
+	// For each stack, move that row up.
+	for sri := len(stack) - 1; sri > 0; sri-- {
+		for se := range stack[sri] {
+			st.set(wh, se.Add(move), wh.At(se))
+			st.set(wh, se, 0)
+		}
+	}
+	st.to = robot.Add(move)
+	st.set(wh, st.to, 0)
+	return st
+}
+
+func gps(wh warehouse) int {
+	s := 0
+	for p, v := range wh.All() {
+		if v == 2 {
+			s += 100*p.Y + p.X
+		}
+	}
+	return s
+}
+
+func init() {
+	aoc.Register(2024, 15, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	m      *grid.Grid[rune]
+	moves  []grid.Point
+	frames anim.Sink
+}
+
+func (s *solver) Animate(frames anim.Sink) {
+	s.frames = frames
+}
+
+// run runs the moves of the given part in the warehouse, checking each one,
+// and returns the sum of the GPS coordinates of the boxes.
+func (s *solver) run(part int, wh warehouse, robot grid.Point, wide bool) (int, error) {
+	sm := newSim(wh, robot, wide, s.moves)
+	s.emit(part, sm)
+	for sm.at < len(sm.moves) {
+		if err := sm.forward(); err != nil {
+			return 0, err
+		}
+		s.emit(part, sm)
+	}
+	return gps(sm.wh), nil
+}
+
+// emit emits the warehouse of the given part as it is in the simulation.
+func (s *solver) emit(part int, sm *sim) {
+	anim.Emit(s.frames, fmt.Sprintf("part %d, move %d of %d", part, sm.at, len(sm.moves)), func() string {
+		return printMap(sm.wh, sm.robot, sm.wide)
+	})
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.m, s.moves, err = extractInput(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	wh, robot := extractWarehouse(s.m)
+	n, err := s.run(1, wh, robot, false)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Int(n), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	wh, robot := extractWideWarehouse(s.m)
+	n, err := s.run(2, wh, robot, true)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Int(n), nil
+}
@@ -0,0 +1,19 @@
+package day15
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseRobots(t *testing.T) {
+	for _, tc := range []struct{ input, err string }{
+		{"#.O\n...\n\n<\n", "no robot in the warehouse"},
+		{"@.O\n..@\n\n<\n", "2:3: another robot"},
+	} {
+		s := &solver{}
+		err := s.Parse(strings.NewReader(tc.input))
+		if err == nil || !strings.HasSuffix(err.Error(), tc.err) {
+			t.Errorf("Parse(%q) = %v, want %s", tc.input, err, tc.err)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package day15
+
+import (
+	"fmt"
+
+	"github.com/liviro/aoc/internal/grid"
+)
+
+// change is a cell of the warehouse changed by a move.
+type change struct {
+	p        grid.Point
+	from, to int
+}
+
+// step is a move applied to the warehouse as a transaction: where the robot
+// went, and the cells that changed, in the order they did. A move into a wall
+// changes nothing.
+type step struct {
+	move     grid.Point
+	from, to grid.Point
+	changes  []change
+}
+
+// set sets the cell at p as part of the step.
+func (st *step) set(wh warehouse, p grid.Point, v int) {
+	if old := wh.At(p); old != v {
+		st.changes = append(st.changes, change{p, old, v})
+		wh.Set(p, v)
+	}
+}
+
+// sim runs the moves of the robot through a warehouse, one step at a time.
+// It keeps the log of the steps taken, which it can undo and redo to seek to
+// any move, and checks the warehouse after each new step.
+type sim struct {
+	wh    warehouse
+	robot grid.Point
+	wide  bool
+	moves []grid.Point
+	// log holds the steps of the moves taken so far, of which the first at
+	// are applied, and the others undone.
+	log []step
+	at  int
+	// boxes is the number of boxes the warehouse started with, and count the
+	// number it holds.
+	boxes, count int
+}
+
+func newSim(wh warehouse, robot grid.Point, wide bool, moves []grid.Point) *sim {
+	s := &sim{wh: wh, robot: robot, wide: wide, moves: moves}
+	for _, v := range wh.All() {
+		if v == 2 {
+			s.boxes++
+		}
+	}
+	s.count = s.boxes
+	return s
+}
+
+// moveRune returns how the move is written in the input.
+func moveRune(move grid.Point) rune {
+	for r, m := range moves {
+		if m == move {
+			return r
+		}
+	}
+	return '?'
+}
+
+// forward takes the next move, redoing its step if it was undone. A new step
+// is checked, and if it breaks the warehouse, it stays applied, for the
+// broken warehouse to be looked at, and the error tells which move broke it.
+func (s *sim) forward() error {
+	if s.at == len(s.moves) {
+		return fmt.Errorf("no move after move %d", s.at)
+	}
+	if s.at < len(s.log) {
+		s.apply(s.log[s.at], false)
+		s.at++
+		return nil
+	}
+	move := s.moves[s.at]
+	var st step
+	if s.wide {
+		st = attemptWideMove(s.wh, s.robot, move)
+	} else {
+		st = attemptMove(s.wh, s.robot, move)
+	}
+	s.robot = st.to
+	s.count += boxDelta(st.changes)
+	s.log = append(s.log, st)
+	s.at++
+	if err := s.check(st); err != nil {
+		return fmt.Errorf("move %d (%c): %w", s.at, moveRune(move), err)
+	}
+	return nil
+}
+
+// back undoes the last move taken, and returns whether there was one.
+func (s *sim) back() bool {
+	if s.at == 0 {
+		return false
+	}
+	s.at--
+	s.apply(s.log[s.at], true)
+	return true
+}
+
+// apply applies the step, or undoes it.
+func (s *sim) apply(st step, undo bool) {
+	if undo {
+		for i := len(st.changes) - 1; i >= 0; i-- {
+			s.wh.Set(st.changes[i].p, st.changes[i].from)
+		}
+		s.robot = st.from
+		s.count -= boxDelta(st.changes)
+		return
+	}
+	for _, c := range st.changes {
+		s.wh.Set(c.p, c.to)
+	}
+	s.robot = st.to
+	s.count += boxDelta(st.changes)
+}
+
+// seek goes back or forward to the warehouse after the first n moves.
+func (s *sim) seek(n int) error {
+	if n < 0 || n > len(s.moves) {
+		return fmt.Errorf("no move %d among %d", n, len(s.moves))
+	}
+	for s.at > n {
+		s.back()
+	}
+	for s.at < n {
+		if err := s.forward(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// do takes a new move after those applied, dropping the undone ones.
+func (s *sim) do(move grid.Point) error {
+	s.moves = append(s.moves[:s.at:s.at], move)
+	s.log = s.log[:s.at]
+	return s.forward()
+}
+
+// boxDelta returns by how many boxes the changes grow the warehouse.
+func boxDelta(cs []change) int {
+	d := 0
+	for _, c := range cs {
+		if c.from == 2 {
+			d--
+		}
+		if c.to == 2 {
+			d++
+		}
+	}
+	return d
+}
+
+// check checks the warehouse after the step: walls stay put, the boxes are
+// all there, each half of a wide box next to the other, and the robot stands
+// on the floor. Only the cells changed by the step, and those next to them,
+// are looked at.
+func (s *sim) check(st step) error {
+	if s.count != s.boxes {
+		return fmt.Errorf("%d boxes, want %d", s.count, s.boxes)
+	}
+	if v := s.wh.At(s.robot); v != 0 {
+		return fmt.Errorf("robot at %v on %c", s.robot, cellRune(v, s.wide))
+	}
+	for _, c := range st.changes {
+		if c.from == 1 || c.to == 1 {
+			return fmt.Errorf("%c at %v became %c", cellRune(c.from, s.wide), c.p, cellRune(c.to, s.wide))
+		}
+		for _, p := range []grid.Point{c.p.Add(grid.Left), c.p, c.p.Add(grid.Right)} {
+			if err := s.paired(p); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// paired checks that a box half at p is next to its other half, or that there
+// are no halves at all in a narrow warehouse.
+func (s *sim) paired(p grid.Point) error {
+	v, _ := s.wh.Get(p)
+	switch {
+	case v == 3 && !s.wide:
+		return fmt.Errorf("box half at %v in a narrow warehouse", p)
+	case v == 2 && s.wide:
+		if r, _ := s.wh.Get(p.Add(grid.Right)); r != 3 {
+			return fmt.Errorf("[ at %v followed by %c", p, cellRune(r, s.wide))
+		}
+	case v == 3:
+		if l, _ := s.wh.Get(p.Add(grid.Left)); l != 2 {
+			return fmt.Errorf("] at %v preceded by %c", p, cellRune(l, s.wide))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package day15
+
+import (
+	"math/rand/v2"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/liviro/aoc/internal/grid"
+)
+
+// load returns the warehouse map and moves of an example.
+func load(t *testing.T, input string) *solver {
+	t.Helper()
+	f, err := os.Open(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	s := &solver{}
+	if err := s.Parse(f); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestSeek(t *testing.T) {
+	rng := rand.New(rand.NewPCG(15, 2024))
+	for _, input := range []string{"input-test-sm.txt", "input-test-lg.txt", "input-test-wide.txt"} {
+		for _, wide := range []bool{false, true} {
+			s := load(t, input)
+			wh, robot := extractWarehouse(s.m)
+			if wide {
+				wh, robot = extractWideWarehouse(s.m)
+			}
+			sm := newSim(wh, robot, wide, s.moves)
+			// The warehouse after each move, as run straight through.
+			var want []string
+			want = append(want, printMap(sm.wh, sm.robot, wide))
+			for sm.at < len(sm.moves) {
+				if err := sm.forward(); err != nil {
+					t.Fatalf("%s: %v", input, err)
+				}
+				want = append(want, printMap(sm.wh, sm.robot, wide))
+			}
+			for range 50 {
+				n := rng.IntN(len(want))
+				if err := sm.seek(n); err != nil {
+					t.Fatalf("%s: seek(%d): %v", input, n, err)
+				}
+				if got := printMap(sm.wh, sm.robot, wide); got != want[n] {
+					t.Fatalf("%s (wide: %v): after seeking to move %d, got\n%swant\n%s", input, wide, n, got, want[n])
+				}
+			}
+			for sm.back() {
+			}
+			if got := printMap(sm.wh, sm.robot, wide); got != want[0] || sm.at != 0 {
+				t.Errorf("%s (wide: %v): after undoing all moves, got\n%swant\n%s", input, wide, got, want[0])
+			}
+			if err := sm.seek(len(want)); err == nil {
+				t.Errorf("%s: seeking past the last move succeeded", input)
+			}
+		}
+	}
+}
+
+func TestDo(t *testing.T) {
+	s := load(t, "input-test-lg.txt")
+	wh, robot := extractWideWarehouse(s.m)
+	sm := newSim(wh, robot, true, s.moves)
+	if err := sm.seek(300); err != nil {
+		t.Fatal(err)
+	}
+	if err := sm.seek(100); err != nil {
+		t.Fatal(err)
+	}
+	if err := sm.do(s.moves[0]); err != nil {
+		t.Fatal(err)
+	}
+	if len(sm.moves) != 101 || len(sm.log) != 101 || sm.at != 101 {
+		t.Errorf("after a new move at 100: %d moves, %d steps, at %d, want 101 of each", len(sm.moves), len(sm.log), sm.at)
+	}
+
+	wh, robot = extractWideWarehouse(s.m)
+	fresh := newSim(wh, robot, true, append(s.moves[:100:100], s.moves[0]))
+	if err := fresh.seek(101); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := printMap(sm.wh, sm.robot, true), printMap(fresh.wh, fresh.robot, true); got != want {
+		t.Errorf("got\n%swant\n%s", got, want)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	s := &solver{}
+	if err := s.Parse(strings.NewReader("#######\n#.....#\n#..O..#\n#..@..#\n#######\n\n<>^\n")); err != nil {
+		t.Fatal(err)
+	}
+	wh, robot := extractWideWarehouse(s.m)
+	sm := newSim(wh, robot, true, s.moves)
+	if err := sm.seek(3); err != nil {
+		t.Fatalf("the intact warehouse failed its check: %v", err)
+	}
+
+	// Lose the right half of the box before pushing it up: only the third
+	// move touches it.
+	wh, robot = extractWideWarehouse(s.m)
+	wh.Set(robot.Add(grid.Up).Add(grid.Right), 0)
+	sm = newSim(wh, robot, true, s.moves)
+	err := sm.seek(3)
+	if want := "move 3 (^): [ at (6, 1) followed by ."; err == nil || err.Error() != want {
+		t.Errorf("seek(3) = %v, want %s", err, want)
+	}
+	if sm.at != 3 {
+		t.Errorf("stopped at move %d, want the broken warehouse after move 3", sm.at)
+	}
+
+	for _, tc := range []struct {
+		name string
+		// breaks breaks the warehouse with the robot at the given spot, as
+		// a step would.
+		breaks func(sm *sim, st *step, robot grid.Point)
+		want   string
+	}{
+		{"lost box", func(sm *sim, st *step, robot grid.Point) {
+			st.set(sm.wh, robot.Add(grid.Up), 0)
+			st.set(sm.wh, robot.Add(grid.Up).Add(grid.Right), 0)
+			sm.count += boxDelta(st.changes)
+		}, "0 boxes, want 1"},
+		{"robot on a box", func(sm *sim, st *step, robot grid.Point) {
+			sm.robot = robot.Add(grid.Up)
+		}, "robot at (6, 2) on ["},
+		{"moved wall", func(sm *sim, st *step, robot grid.Point) {
+			st.set(sm.wh, robot.Add(grid.Down), 0)
+		}, "# at (6, 4) became ."},
+		{"split box", func(sm *sim, st *step, robot grid.Point) {
+			st.set(sm.wh, robot.Add(grid.Up), 0)
+		}, "] at (7, 2) preceded by ."},
+	} {
+		wh, robot := extractWideWarehouse(s.m)
+		sm := newSim(wh, robot, true, s.moves)
+		st := step{from: robot, to: robot}
+		tc.breaks(sm, &st, robot)
+		if err := sm.check(st); err == nil || err.Error() != tc.want {
+			t.Errorf("%s: check() = %v, want %s", tc.name, err, tc.want)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package day15
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/liviro/aoc/internal/anim"
+	"github.com/liviro/aoc/internal/grid"
+)
+
+// clearScreen moves the cursor home and clears the terminal, to redraw it.
+const clearScreen = "\x1b[H\x1b[2J"
+
+const playHelp = "arrows or ^v<>: move  u: undo  r: restart  w: narrow/wide  q: quit"
+
+var arrowMoves = map[anim.Key]grid.Point{
+	anim.ArrowUp:    grid.Up,
+	anim.ArrowRight: grid.Right,
+	anim.ArrowDown:  grid.Down,
+	anim.ArrowLeft:  grid.Left,
+}
+
+// Play lets the robot be moved by hand around the parsed warehouse, narrow
+// at first, redrawing it with its GPS score after each key.
+func (s *solver) Play(keys anim.KeyReader, out io.Writer) error {
+	wide := false
+	var sm *sim
+	start := func() {
+		wh, robot := extractWarehouse(s.m)
+		if wide {
+			wh, robot = extractWideWarehouse(s.m)
+		}
+		sm = newSim(wh, robot, wide, nil)
+	}
+	start()
+	status := ""
+	for {
+		drawGame(out, sm, status)
+		k, err := keys.ReadKey()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		status = ""
+		move, ok := arrowMoves[k]
+		if !ok {
+			move, ok = moves[rune(k)]
+		}
+		switch {
+		case ok:
+			if err := sm.do(move); err != nil {
+				status = "error: " + err.Error()
+			}
+		case k == 'u':
+			if !sm.back() {
+				status = "nothing to undo"
+			}
+		case k == 'r':
+			start()
+		case k == 'w':
+			wide = !wide
+			start()
+		case k == 'q':
+			return nil
+		default:
+			status = fmt.Sprintf("unknown key %q", rune(k))
+		}
+	}
+}
+
+// drawGame redraws the warehouse of the game, under its score, and above the
+// keys to play with and the status of the last one.
+func drawGame(out io.Writer, sm *sim, status string) {
+	kind := "narrow"
+	if sm.wide {
+		kind = "wide"
+	}
+	fmt.Fprintf(out, "%sGPS: %d  moves: %d  %s\n", clearScreen, gps(sm.wh), sm.at, kind)
+	fmt.Fprint(out, printMap(sm.wh, sm.robot, sm.wide))
+	fmt.Fprintln(out, playHelp)
+	fmt.Fprintln(out, status)
+}
@@ -0,0 +1,110 @@
+package day15
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/liviro/aoc/internal/anim"
+)
+
+// play plays the keys on the example, and returns the last screen drawn.
+func play(t *testing.T, input, keys string) string {
+	t.Helper()
+	s := load(t, input)
+	var b strings.Builder
+	if err := s.Play(anim.NewKeyReader(strings.NewReader(keys)), &b); err != nil {
+		t.Fatal(err)
+	}
+	screens := strings.Split(b.String(), clearScreen)
+	return screens[len(screens)-1]
+}
+
+func TestPlay(t *testing.T) {
+	for _, tc := range []struct {
+		name, input, keys string
+		want              []string
+	}{
+		{
+			name:  "the example's moves, wide",
+			input: "input-test-wide.txt",
+			keys:  "w<vv<<^^<<^^",
+			want:  []string{"GPS: 618  moves: 11  wide\n"},
+		},
+		{
+			name:  "arrows",
+			input: "input-test-wide.txt",
+			keys:  "w\x1b[D\x1b[B\x1b[B\x1b[D\x1b[D\x1b[A\x1b[A\x1b[D\x1b[D\x1b[A\x1b[A",
+			want:  []string{"GPS: 618  moves: 11  wide\n"},
+		},
+		{
+			name:  "the small example, narrow",
+			input: "input-test-sm.txt",
+			keys:  "<^^>>>vv<v>>v<<",
+			want: []string{
+				"GPS: 2028  moves: 15  narrow\n",
+				"########\n#....OO#\n##.....#\n#.....O#\n#.#O@..#\n#...O..#\n#...O..#\n########\n",
+			},
+		},
+		{
+			name:  "undo",
+			input: "input-test-sm.txt",
+			keys:  "<^^>>>vv<v>>v<<uuuuu",
+			want:  []string{play(t, "input-test-sm.txt", "<^^>>>vv<v")},
+		},
+		{
+			name:  "new moves after undoing",
+			input: "input-test-sm.txt",
+			keys:  "<^^>>>vv<vuu^u>>v<<",
+			want:  []string{play(t, "input-test-sm.txt", "<^^>>>vv>>v<<")},
+		},
+		{
+			name:  "nothing to undo",
+			input: "input-test-sm.txt",
+			keys:  ">uu",
+			want:  []string{"moves: 0", "nothing to undo\n"},
+		},
+		{
+			name:  "restart and quit",
+			input: "input-test-sm.txt",
+			keys:  ">>r>q>>>",
+			want:  []string{"moves: 1  narrow"},
+		},
+		{
+			name:  "unknown key",
+			input: "input-test-sm.txt",
+			keys:  "x",
+			want:  []string{"unknown key 'x'\n"},
+		},
+	} {
+		got := play(t, tc.input, tc.keys)
+		for _, w := range tc.want {
+			if !strings.Contains(got, w) {
+				t.Errorf("%s: got\n%s\nwant it to contain\n%s", tc.name, got, w)
+			}
+		}
+	}
+}
+
+func TestPlayOffEdge(t *testing.T) {
+	// A warehouse without walls, whose edges stop the robot and the box as
+	// walls would.
+	s := &solver{}
+	if err := s.Parse(strings.NewReader("@.O\n...\n\n<\n")); err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct{ keys, want string }{
+		{">>>>", ".@O\n...\n"},
+		{"^<<vvv", "..O\n@..\n"},
+		{"w>>>>>>>", "...@[]\n......\n"},
+		{"wv>>>>^^^", "....[]\n....@.\n"},
+	} {
+		var b strings.Builder
+		if err := s.Play(anim.NewKeyReader(strings.NewReader(tc.keys)), &b); err != nil {
+			t.Fatalf("%s: %v", tc.keys, err)
+		}
+		screens := strings.Split(b.String(), clearScreen)
+		if got := screens[len(screens)-1]; !strings.Contains(got, tc.want) {
+			t.Errorf("%s: got\n%s\nwant it to contain\n%s", tc.keys, got, tc.want)
+		}
+	}
+}
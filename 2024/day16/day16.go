@@ -0,0 +1,137 @@
+package day16
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/liviro/aoc/internal/anim"
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/grid"
+	"github.com/liviro/aoc/internal/search"
+)
+
+type maze = *grid.Grid[rune]
+
+// reindeer is where a reindeer stands in the maze, and which way it faces.
+type reindeer struct {
+	pos, dir grid.Point
+}
+
+func extractMaze(r io.Reader) (maze, grid.Point, grid.Point, error) {
+	m, err := grid.Read(r, grid.RunesOf("#.SE"))
+	if err != nil {
+		return nil, grid.Point{}, grid.Point{}, err
+	}
+	start, okS := m.Find(func(v rune) bool { return v == 'S' })
+	end, okE := m.Find(func(v rune) bool { return v == 'E' })
+	if !okS || !okE {
+		return nil, grid.Point{}, grid.Point{}, errors.New("maze needs a start and an end")
+	}
+	return m, start, end, nil
+}
+
+// moves returns the moves a reindeer can make: stepping forward for 1 point,
+// or turning for 1000 points.
+func moves(m maze) search.Neighbors[reindeer] {
+	return func(r reindeer) []search.Edge[reindeer] {
+		es := []search.Edge[reindeer]{
+			{To: reindeer{r.pos, r.dir.TurnLeft()}, Cost: 1000},
+			{To: reindeer{r.pos, r.dir.TurnRight()}, Cost: 1000},
+		}
+		if v, ok := m.Get(r.pos.Add(r.dir)); ok && v != '#' {
+			es = append(es, search.Edge[reindeer]{To: reindeer{r.pos.Add(r.dir), r.dir}, Cost: 1})
+		}
+		return es
+	}
+}
+
+// race finds the cheapest paths from the start, facing east, to every spot of
+// the maze. It returns the search along with the reindeers standing on the end
+// at the lowest score.
+func race(m maze, start, end grid.Point) (*search.Result[reindeer], []reindeer) {
+	res := search.AStar(reindeer{start, grid.Right}, moves(m), func(r reindeer) bool {
+		return r.pos == end
+	}, nil)
+	best, ends := math.MaxInt, []reindeer{}
+	for _, d := range grid.Dirs4 {
+		r := reindeer{end, d}
+		c, ok := res.Dist[r]
+		switch {
+		case !ok || c > best:
+		case c < best:
+			best, ends = c, []reindeer{r}
+		default:
+			ends = append(ends, r)
+		}
+	}
+	return res, ends
+}
+
+func vis(m maze, gs map[grid.Point]struct{}) string {
+	return m.Render(func(c grid.Point, v rune) rune {
+		if _, good := gs[c]; good {
+			return 'O'
+		}
+		return v
+	})
+}
+
+var errNoPath = errors.New("no path from S to E")
+
+func part1(m maze, start, end grid.Point) (int, error) {
+	res, ends := race(m, start, end)
+	if len(ends) == 0 {
+		return 0, errNoPath
+	}
+	return res.Dist[ends[0]], nil
+}
+
+func part2(m maze, start, end grid.Point, frames anim.Sink) (int, error) {
+	res, ends := race(m, start, end)
+	if len(ends) == 0 {
+		return 0, errNoPath
+	}
+	gs := map[grid.Point]struct{}{}
+	for r := range res.OnShortestPaths(ends...) {
+		gs[r.pos] = struct{}{}
+	}
+	anim.Emit(frames, fmt.Sprintf("part 2, %d seats on the best paths", len(gs)), func() string { return vis(m, gs) })
+	return len(gs), nil
+}
+
+func init() {
+	aoc.Register(2024, 16, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	m          maze
+	start, end grid.Point
+	frames     anim.Sink
+}
+
+func (s *solver) Animate(frames anim.Sink) {
+	s.frames = frames
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.m, s.start, s.end, err = extractMaze(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	n, err := part1(s.m, s.start, s.end)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Int(n), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	n, err := part2(s.m, s.start, s.end, s.frames)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Int(n), nil
+}
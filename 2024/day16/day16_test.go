@@ -0,0 +1,19 @@
+package day16
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNoPath(t *testing.T) {
+	s := &solver{}
+	if err := s.Parse(strings.NewReader("#####\n#S#E#\n#####\n")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Part1(); err != errNoPath {
+		t.Errorf("Part1() = %v, want %v", err, errNoPath)
+	}
+	if _, err := s.Part2(); err != errNoPath {
+		t.Errorf("Part2() = %v, want %v", err, errNoPath)
+	}
+}
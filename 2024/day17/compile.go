@@ -0,0 +1,116 @@
+package day17
+
+import (
+	"fmt"
+	"math"
+)
+
+// compiled is a program decoded once into a closure per position, for running
+// it many times over. It behaves exactly as the machine does, but keeps its
+// registers and output buffer between runs rather than allocating them.
+type compiled struct {
+	a, b, c int
+	// ops holds, for each position the pointer can be at, the instruction
+	// starting there, which executes and returns the next position.
+	ops []func() int
+	out []int
+}
+
+// compile decodes the program, which must consist of 3-bit numbers.
+func compile(program []int) (*compiled, error) {
+	p := &compiled{ops: make([]func() int, len(program))}
+	for i, v := range program {
+		if v < 0 || v > 7 {
+			return nil, fmt.Errorf("%d: %d is not a 3-bit number", i, v)
+		}
+	}
+	for i, op := range program {
+		if i+1 == len(program) {
+			p.ops[i] = p.last(op)
+			continue
+		}
+		p.ops[i] = p.instruction(op, program[i+1], i+2)
+	}
+	return p, nil
+}
+
+// instruction decodes the opcode and operand at one position, given the
+// position after them.
+func (p *compiled) instruction(op, arg, next int) func() int {
+	src := p.combo(arg)
+	if src == nil && op != 1 && op != 3 && op != 4 {
+		return func() int { panic("Invalid combo operand!") }
+	}
+	switch op {
+	case 0: // adv
+		return func() int { p.a >>= *src; return next }
+	case 1: // bxl
+		return func() int { p.b ^= arg; return next }
+	case 2: // bst
+		return func() int { p.b = *src % 8; return next }
+	case 3: // jnz
+		return func() int {
+			if p.a == 0 {
+				return next
+			}
+			return arg
+		}
+	case 4: // bxc
+		return func() int { p.b ^= p.c; return next }
+	case 5: // out
+		return func() int { p.out = append(p.out, *src%8); return next }
+	case 6: // bdv
+		return func() int { p.b = p.a >> *src; return next }
+	default: // cdv
+		return func() int { p.c = p.a >> *src; return next }
+	}
+}
+
+// last decodes an opcode at the very end of the program, which has no operand
+// to read: only bxc, and jnz when it does not jump, can execute there.
+func (p *compiled) last(op int) func() int {
+	missing := func() int { panic("operand past the end of the program") }
+	switch op {
+	case 3:
+		return func() int {
+			if p.a == 0 {
+				return len(p.ops) + 1
+			}
+			return missing()
+		}
+	case 4:
+		return func() int { p.b ^= p.c; return len(p.ops) + 1 }
+	}
+	return missing
+}
+
+// combo returns where the combo operand's value is read from: a register, or
+// the operand itself for literals. It is nil for the invalid operand 7.
+func (p *compiled) combo(arg int) *int {
+	switch arg {
+	case 4:
+		return &p.a
+	case 5:
+		return &p.b
+	case 6:
+		return &p.c
+	case 7:
+		return nil
+	}
+	return &arg
+}
+
+// run runs the program from the given registers until it halts or has output
+// limit values, if limit is positive. The output is only valid until the next
+// run.
+func (p *compiled) run(a, b, c, limit int) []int {
+	p.a, p.b, p.c = a, b, c
+	p.out = p.out[:0]
+	if limit <= 0 {
+		limit = math.MaxInt
+	}
+	for i := 0; i < len(p.ops) && len(p.out) < limit; {
+		i = p.ops[i]()
+	}
+	return p.out
+}
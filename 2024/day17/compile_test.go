@@ -0,0 +1,92 @@
+package day17
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// interpret runs the machine for at most 10000 instructions, and returns its
+// output, whether it halted and whether an instruction failed, where the
+// compiled program panics, losing its output.
+func interpret(m machine) (out []int, halted, failed bool) {
+	for range 10_000 {
+		running, err := m.step()
+		if err != nil {
+			return nil, false, true
+		}
+		if !running {
+			return m.output, true, false
+		}
+	}
+	return m.output, false, false
+}
+
+func runCompiled(p *compiled, a, b, c int) (out []int, panicked bool) {
+	defer func() {
+		if recover() != nil {
+			panicked = true
+		}
+	}()
+	return p.run(a, b, c, 0), false
+}
+
+func FuzzCompile(f *testing.F) {
+	f.Add([]byte{0, 1, 5, 4, 3, 0}, uint64(729), uint64(0), uint64(0))
+	f.Add([]byte{0, 3, 5, 4, 3, 0}, uint64(117440), uint64(0), uint64(0))
+	f.Add([]byte{2, 4, 1, 2, 7, 5, 4, 1, 1, 3, 5, 5, 0, 3, 3, 0}, uint64(190615597431823), uint64(0), uint64(0))
+	f.Add([]byte{1, 7, 3, 1, 4}, uint64(1), uint64(2), uint64(3))
+	f.Fuzz(func(t *testing.T, code []byte, a, b, c uint64) {
+		program := make([]int, len(code))
+		for i, v := range code {
+			program[i] = int(v % 8)
+		}
+		// The puzzle's registers are never negative, where shifting and
+		// truncating division would differ.
+		m := machine{a: int(a >> 1), b: int(b >> 1), c: int(c >> 1), program: program}
+		want, halted, wantPanic := interpret(m)
+		if !halted && !wantPanic {
+			t.Skip("program does not halt")
+		}
+		p, err := compile(program)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, gotPanic := runCompiled(p, m.a, m.b, m.c)
+		if gotPanic != wantPanic || !slices.Equal(got, want) {
+			t.Errorf("%v from %v: compiled output %v (panicked: %v), interpreter %v (panicked: %v)",
+				program, m.registers(), got, gotPanic, want, wantPanic)
+		}
+	})
+}
+
+// BenchmarkCompile runs the program of a typical input from many values of A,
+// as the search does, and reports how many times faster the compiled program
+// is than the interpreter.
+func BenchmarkCompile(b *testing.B) {
+	m := machine{program: []int{2, 4, 1, 2, 7, 5, 4, 1, 1, 3, 5, 5, 0, 3, 3, 0}}
+	p, err := compile(m.program)
+	if err != nil {
+		b.Fatal(err)
+	}
+	const batch = 1000
+	var interpreted, compiled time.Duration
+	for a := 1 << 40; b.Loop(); a += batch {
+		start := time.Now()
+		for i := range batch {
+			mc := m.copy()
+			mc.a = a + i
+			if err := mc.run(); err != nil {
+				b.Fatal(err)
+			}
+		}
+		interpreted += time.Since(start)
+		start = time.Now()
+		for i := range batch {
+			p.run(a+i, m.b, m.c, 0)
+		}
+		compiled += time.Since(start)
+	}
+	b.ReportMetric(float64(interpreted)/float64(compiled), "speedup")
+	b.ReportMetric(float64(compiled.Nanoseconds())/float64(b.N*batch), "ns/compiled-run")
+}
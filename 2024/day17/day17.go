@@ -0,0 +1,79 @@
+package day17
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
+)
+
+func extractMachine(r io.Reader) (machine, error) {
+	m := machine{program: []int{}}
+	sections, err := parse.Sections(r)
+	if err != nil {
+		return m, err
+	}
+	if len(sections) != 2 || len(sections[0]) != 3 || len(sections[1]) != 1 {
+		return m, errors.New("expected three registers, a blank line and a program")
+	}
+	for i, reg := range []*int{&m.a, &m.b, &m.c} {
+		if err := parse.Match(sections[0][i], fmt.Sprintf("Register %c: %%d", 'A'+i), reg); err != nil {
+			return m, err
+		}
+		if *reg < 0 {
+			return m, sections[0][i].Errorf("register %c is negative", 'A'+i)
+		}
+	}
+	prog, err := sections[1][0].TrimPrefix("Program: ")
+	if err != nil {
+		return m, err
+	}
+	fs := parse.Split(prog, ",")
+	for _, f := range fs {
+		i, err := f.Int()
+		if err != nil {
+			return m, err
+		}
+		if i < 0 || i > 7 {
+			return m, f.Errorf("%d is not a 3-bit number", i)
+		}
+		m.program = append(m.program, i)
+	}
+	for p := 0; p+1 < len(m.program); p += 2 {
+		if usesCombo(m.program[p]) && m.program[p+1] == 7 {
+			return m, fs[p+1].Errorf("invalid combo operand 7")
+		}
+	}
+	return m, nil
+}
+
+func init() {
+	aoc.Register(2024, 17, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	m machine
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.m, err = extractMachine(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	m := s.m.copy()
+	if err := m.run(); err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Text(m.printOutput()), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	a, err := quine(s.m)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Int(a), nil
+}
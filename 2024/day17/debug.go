@@ -0,0 +1,242 @@
+package day17
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// maxSteps bounds how many instructions continue runs before pausing, as a
+// program may well loop forever.
+const maxSteps = 1_000_000
+
+const debugHelp = `commands:
+  step [n]         execute n instructions (default 1)
+  continue         run until a breakpoint or the end of the program
+  break [p]        break when the pointer reaches p; list breakpoints if no p
+  delete p         remove the breakpoint at p
+  watch r          report changes to register r (A, B or C)
+  unwatch r        stop reporting changes to register r
+  trace            toggle printing every instruction executed
+  regs             print the registers, pointer and output
+  list             disassemble the program
+  set r v          set register r to v
+  reset            restart the program from its initial state
+  help             print this help
+  quit             leave the debugger
+`
+
+// debugger steps through the execution of a machine, driven by commands.
+type debugger struct {
+	init    machine
+	m       machine
+	breaks  map[int]bool
+	watches []int // Indices of the watched registers: 0 for A, 1 for B, 2 for C.
+	trace   bool
+	out     io.Writer
+}
+
+func newDebugger(m machine, out io.Writer) *debugger {
+	return &debugger{init: m.copy(), m: m.copy(), breaks: map[int]bool{}, out: out}
+}
+
+// Debug runs an interactive debugger over the parsed program, reading
+// commands from in until it ends or a quit command.
+func (s *solver) Debug(in io.Reader, out io.Writer) error {
+	d := newDebugger(s.m, out)
+	fmt.Fprint(out, disassemble(d.m.program, d.m.pointer))
+	d.regs()
+	sc := bufio.NewScanner(in)
+	for {
+		fmt.Fprint(out, "(day17) ")
+		if !sc.Scan() {
+			fmt.Fprintln(out)
+			return sc.Err()
+		}
+		if !d.command(strings.Fields(sc.Text())) {
+			return nil
+		}
+	}
+}
+
+// command executes a command, and returns false if it was to quit.
+func (d *debugger) command(args []string) bool {
+	if len(args) == 0 {
+		return true
+	}
+	var err error
+	switch args[0] {
+	case "s", "step":
+		n := 1
+		if len(args) > 1 {
+			n, err = strconv.Atoi(args[1])
+		}
+		if err == nil {
+			for i := 0; i < n && d.exec(); i++ {
+			}
+			d.where()
+		}
+	case "c", "continue":
+		d.cont()
+	case "b", "break":
+		if len(args) == 1 {
+			d.listBreaks()
+			break
+		}
+		var p int
+		if p, err = d.pointerArg(args[1]); err == nil {
+			d.breaks[p] = true
+		}
+	case "d", "delete":
+		if len(args) < 2 {
+			err = fmt.Errorf("delete needs a pointer")
+			break
+		}
+		var p int
+		if p, err = strconv.Atoi(args[1]); err == nil {
+			delete(d.breaks, p)
+		}
+	case "w", "watch", "unwatch":
+		var r int
+		if r, err = registerArg(args); err == nil {
+			d.watches = slices.DeleteFunc(d.watches, func(w int) bool { return w == r })
+			if args[0] != "unwatch" {
+				d.watches = append(d.watches, r)
+			}
+		}
+	case "t", "trace":
+		d.trace = !d.trace
+		fmt.Fprintf(d.out, "trace %s\n", map[bool]string{true: "on", false: "off"}[d.trace])
+	case "r", "regs":
+		d.regs()
+	case "l", "list":
+		fmt.Fprint(d.out, disassemble(d.m.program, d.m.pointer))
+	case "set":
+		var r, v int
+		if r, err = registerArg(args); err == nil {
+			if len(args) < 3 {
+				err = fmt.Errorf("set needs a value")
+			} else if v, err = strconv.Atoi(args[2]); err == nil {
+				*d.m.register(r) = v
+			}
+		}
+	case "reset":
+		d.m = d.init.copy()
+		d.where()
+	case "h", "help":
+		fmt.Fprint(d.out, debugHelp)
+	case "q", "quit":
+		return false
+	default:
+		err = fmt.Errorf("unknown command %q; try help", args[0])
+	}
+	if err != nil {
+		fmt.Fprintln(d.out, "error:", err)
+	}
+	return true
+}
+
+// exec executes the instruction at the pointer as the machine does, reporting
+// it when tracing, along with changes to watched registers. It returns false
+// if the program has ended instead, or if the instruction failed, which it
+// reports.
+func (d *debugger) exec() bool {
+	p := d.m.pointer
+	if d.m.halted() {
+		return false
+	}
+	if d.trace {
+		mn, eff := instruction(d.m.program, p)
+		fmt.Fprintf(d.out, "%2d: %-10s ; %s\n", p, mn, eff)
+	}
+	before := d.m.registers()
+	outs := len(d.m.output)
+	if _, err := d.m.step(); err != nil {
+		fmt.Fprintln(d.out, "error:", err)
+		return false
+	}
+	after := d.m.registers()
+	for _, r := range d.watches {
+		if before[r] != after[r] {
+			fmt.Fprintf(d.out, "%c: %d -> %d\n", 'A'+r, before[r], after[r])
+		}
+	}
+	if d.trace && len(d.m.output) > outs {
+		fmt.Fprintf(d.out, "output: %d\n", d.m.output[len(d.m.output)-1])
+	}
+	return true
+}
+
+// cont runs until the pointer reaches a breakpoint, the program ends, or
+// maxSteps instructions were executed.
+func (d *debugger) cont() {
+	for i := 0; ; i++ {
+		if i == maxSteps {
+			fmt.Fprintf(d.out, "paused after %d instructions\n", maxSteps)
+			break
+		}
+		if !d.exec() || d.breaks[d.m.pointer] {
+			break
+		}
+	}
+	d.where()
+}
+
+// where reports where the program stopped.
+func (d *debugger) where() {
+	if d.m.halted() {
+		fmt.Fprintf(d.out, "halted; output: %s\n", d.m.printOutput())
+		return
+	}
+	mn, eff := instruction(d.m.program, d.m.pointer)
+	at := "at"
+	if d.breaks[d.m.pointer] {
+		at = "at breakpoint"
+	}
+	fmt.Fprintf(d.out, "stopped %s %2d: %-10s ; %s\n", at, d.m.pointer, mn, eff)
+}
+
+func (d *debugger) regs() {
+	for i, v := range d.m.registers() {
+		fmt.Fprintf(d.out, "%c: %d (octal %o)\n", 'A'+i, v, v)
+	}
+	fmt.Fprintf(d.out, "pointer: %d\noutput: %s\n", d.m.pointer, d.m.printOutput())
+}
+
+func (d *debugger) listBreaks() {
+	ps := []int{}
+	for p := range d.breaks {
+		ps = append(ps, p)
+	}
+	slices.Sort(ps)
+	fmt.Fprintf(d.out, "breakpoints: %v\n", ps)
+}
+
+func (d *debugger) pointerArg(s string) (int, error) {
+	p, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, err
+	}
+	if p < 0 || p >= len(d.m.program) || p%2 != 0 {
+		return 0, fmt.Errorf("no instruction at %d", p)
+	}
+	return p, nil
+}
+
+func registerArg(args []string) (int, error) {
+	if len(args) < 2 {
+		return 0, fmt.Errorf("%s needs a register", args[0])
+	}
+	switch strings.ToUpper(args[1]) {
+	case "A":
+		return 0, nil
+	case "B":
+		return 1, nil
+	case "C":
+		return 2, nil
+	}
+	return 0, fmt.Errorf("no register %q", args[1])
+}
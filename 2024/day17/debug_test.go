@@ -0,0 +1,118 @@
+package day17
+
+import (
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+func TestDisassemble(t *testing.T) {
+	got := disassemble([]int{2, 4, 1, 2, 7, 5, 0, 3, 5, 5, 3, 0}, 4)
+	want := `    0: bst A%8    ; B = A % 8
+    2: bxl B^2    ; B = B ^ 2
+->  4: cdv A>>B   ; C = A >> B
+    6: adv A>>3   ; A = A >> 3
+    8: out B%8    ; output B % 8
+   10: jnz 0      ; if A != 0 goto 0
+`
+	if got != want {
+		t.Errorf("disassemble() =\n%s\nwant\n%s", got, want)
+	}
+	for _, tc := range []struct {
+		program []int
+		want    string
+	}{
+		{[]int{1, 3, 4}, "    2: bxc B^C    ; B = B ^ C\n"},
+		{[]int{1, 3, 3}, "    2: jnz ?      ; if A != 0 fail: missing operand\n"},
+		{[]int{1, 3, 5}, "    2: out        ; fail: missing operand\n"},
+	} {
+		if got := disassemble(tc.program, -1); !strings.HasSuffix(got, tc.want) {
+			t.Errorf("disassemble(%v) =\n%s\nwant it to end in\n%s", tc.program, got, tc.want)
+		}
+	}
+}
+
+func TestDebug(t *testing.T) {
+	s := &solver{m: machine{a: 729, program: []int{0, 1, 5, 4, 3, 0}}}
+	script := "break 4\ncontinue\ncontinue\nwatch A\nstep\nreset\ncontinue\ndelete 4\ncontinue\nquit\nstep\n"
+	var out strings.Builder
+	if err := s.Debug(strings.NewReader(script), &out); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"stopped at breakpoint  4: jnz 0      ; if A != 0 goto 0\n",
+		"A: 364 -> 182\n",
+		"halted; output: 4,6,3,5,6,3,5,2,1,0\n",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Debug() output lacks %q:\n%s", want, out.String())
+		}
+	}
+	if strings.Count(out.String(), "stopped at breakpoint") != 3 {
+		t.Errorf("Debug() did not stop at the breakpoint thrice before it was deleted:\n%s", out.String())
+	}
+	if s.m.a != 729 || len(s.m.output) != 0 {
+		t.Errorf("Debug() changed the parsed machine to %+v", s.m)
+	}
+}
+
+func TestDebugStepError(t *testing.T) {
+	// A jump to 3 reads the operand 2 as the opcode of bst, with the invalid
+	// combo operand 7 after it.
+	s := &solver{m: machine{a: 1, program: []int{3, 3, 1, 2, 7, 5}}}
+	script := "step\nstep\nstep\nreset\ncontinue\nquit\n"
+	var out strings.Builder
+	if err := s.Debug(strings.NewReader(script), &out); err != nil {
+		t.Fatal(err)
+	}
+	want := "error: 4: invalid combo operand 7\n"
+	if strings.Count(out.String(), want) != 3 {
+		t.Errorf("Debug() output lacks %q thrice:\n%s", want, out.String())
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, tc := range []struct{ program, err string }{
+		{"0,7,3,0", "5:12: invalid combo operand 7"},
+		{"1,8,3,0", "5:12: 8 is not a 3-bit number"},
+		{"1,7,3,-1", "5:16: -1 is not a 3-bit number"},
+	} {
+		s := &solver{}
+		err := s.Parse(strings.NewReader("Register A: 1\nRegister B: 0\nRegister C: 0\n\nProgram: " + tc.program + "\n"))
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("Parse(%s) = %v, want %s", tc.program, err, tc.err)
+		}
+	}
+}
+
+// TestDebugMatchesRun continues random programs to their end in the debugger,
+// which must end them as running the machine does, down to a last bxc or jnz
+// without an operand.
+func TestDebugMatchesRun(t *testing.T) {
+	rng := rand.New(rand.NewPCG(17, 11))
+	for range 500 {
+		program := make([]int, 1+rng.IntN(9))
+		for i := range program {
+			program[i] = rng.IntN(8)
+		}
+		m := machine{a: rng.IntN(1 << 10), b: rng.IntN(8), c: rng.IntN(8), program: program}
+		if _, halted, failed := interpret(m); !halted && !failed {
+			continue
+		}
+		run := m.copy()
+		var want string
+		if err := run.run(); err != nil {
+			want = "error: " + err.Error() + "\n"
+		} else {
+			want = "halted; output: " + run.printOutput() + "\n"
+		}
+		s := &solver{m: m}
+		var out strings.Builder
+		if err := s.Debug(strings.NewReader("continue\n"), &out); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("%v from %v: debugger output lacks %q:\n%s", program, m.registers(), want, out.String())
+		}
+	}
+}
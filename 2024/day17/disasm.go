@@ -0,0 +1,78 @@
+package day17
+
+import (
+	"fmt"
+	"strings"
+)
+
+var mnemonics = [8]string{"adv", "bxl", "bst", "jnz", "bxc", "out", "bdv", "cdv"}
+
+// comboName returns how a combo operand reads: a literal, or a register.
+func comboName(op int) string {
+	switch {
+	case op < 4:
+		return fmt.Sprint(op)
+	case op == 4:
+		return "A"
+	case op == 5:
+		return "B"
+	case op == 6:
+		return "C"
+	}
+	return "?"
+}
+
+// instruction returns the instruction at pointer p of the program as its
+// mnemonic with the operand resolved, along with its effect.
+func instruction(program []int, p int) (mnemonic, effect string) {
+	op := program[p]
+	if op < 0 || op > 7 {
+		return fmt.Sprintf("??? %d", op), "invalid opcode"
+	}
+	// Like the machine, bxc ignores a missing operand, and jnz only needs it
+	// to jump.
+	if p+1 >= len(program) {
+		switch op {
+		case 3:
+			return "jnz ?", "if A != 0 fail: missing operand"
+		case 4:
+			return "bxc B^C", "B = B ^ C"
+		}
+		return mnemonics[op], "fail: missing operand"
+	}
+	lit := program[p+1]
+	combo := comboName(lit)
+	switch op {
+	case 0:
+		return "adv A>>" + combo, "A = A >> " + combo
+	case 1:
+		return fmt.Sprintf("bxl B^%d", lit), fmt.Sprintf("B = B ^ %d", lit)
+	case 2:
+		return "bst " + combo + "%8", "B = " + combo + " % 8"
+	case 3:
+		return fmt.Sprintf("jnz %d", lit), fmt.Sprintf("if A != 0 goto %d", lit)
+	case 4:
+		return "bxc B^C", "B = B ^ C"
+	case 5:
+		return "out " + combo + "%8", "output " + combo + " % 8"
+	case 6:
+		return "bdv A>>" + combo, "B = A >> " + combo
+	}
+	return "cdv A>>" + combo, "C = A >> " + combo
+}
+
+// disassemble returns the program as one instruction per line, each preceded
+// by its pointer and followed by its effect. The instruction at mark, if any,
+// is marked with an arrow.
+func disassemble(program []int, mark int) string {
+	var b strings.Builder
+	for p := 0; p < len(program); p += 2 {
+		arrow := "  "
+		if p == mark {
+			arrow = "->"
+		}
+		mn, eff := instruction(program, p)
+		fmt.Fprintf(&b, "%s %2d: %-10s ; %s\n", arrow, p, mn, eff)
+	}
+	return b.String()
+}
@@ -0,0 +1,186 @@
+package day17
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type machine struct {
+	a, b, c int
+	program []int
+	pointer int
+	output  []int
+}
+
+func (m machine) copy() machine {
+	nm := machine{
+		a: m.a, b: m.b, c: m.c, pointer: m.pointer,
+		program: []int{},
+		output:  []int{},
+	}
+	nm.program = append(nm.program, m.program...)
+	nm.output = append(nm.output, m.output...)
+	return nm
+}
+
+func (m machine) combo() int {
+	op := m.program[m.pointer+1]
+	if op < 4 {
+		return op
+	}
+	if op == 4 {
+		return m.a
+	}
+	if op == 5 {
+		return m.b
+	}
+	if op == 6 {
+		return m.c
+	}
+	panic("Invalid combo operand!")
+}
+
+func (m machine) literal() int {
+	return m.program[m.pointer+1]
+}
+
+func (m *machine) adv() {
+	m.a = m.a >> m.combo()
+	m.pointer += 2
+}
+
+func (m *machine) bxl() {
+	m.b = m.b ^ m.literal()
+	m.pointer += 2
+}
+
+func (m *machine) bst() {
+	m.b = m.combo() % 8
+	m.pointer += 2
+}
+
+func (m *machine) jnz() {
+	if m.a == 0 {
+		m.pointer += 2
+		return
+	}
+	m.pointer = m.literal()
+}
+
+func (m *machine) bxc() {
+	m.b = m.b ^ m.c
+	m.pointer += 2
+}
+
+func (m *machine) out() {
+	m.output = append(m.output, m.combo()%8)
+	m.pointer += 2
+}
+
+func (m *machine) bdv() {
+	m.b = m.a >> m.combo()
+	m.pointer += 2
+}
+
+func (m *machine) cdv() {
+	m.c = m.a >> m.combo()
+	m.pointer += 2
+}
+
+// run runs the machine until it halts, or until an instruction fails.
+func (m *machine) run() error {
+	for {
+		running, err := m.step()
+		if err != nil || !running {
+			return err
+		}
+	}
+}
+
+// halted returns whether the pointer has moved past the end of the program.
+func (m machine) halted() bool {
+	return m.pointer >= len(m.program)
+}
+
+// usesCombo returns whether the instruction of the given opcode takes a combo
+// operand.
+func usesCombo(op int) bool {
+	return op == 0 || op == 2 || op == 5 || op == 6 || op == 7
+}
+
+// check returns why the instruction at the pointer cannot execute, if it
+// cannot: its opcode is invalid, it needs an operand past the end of the
+// program, its combo operand is the invalid 7, or it would shift A by a
+// negative amount.
+func (m machine) check() error {
+	op := m.program[m.pointer]
+	switch {
+	case op < 0 || op > 7:
+		return fmt.Errorf("%d: invalid opcode %d", m.pointer, op)
+	case op == 4 || (op == 3 && m.a == 0):
+		// No operand to read.
+		return nil
+	case m.pointer+1 >= len(m.program):
+		return fmt.Errorf("%d: operand past the end of the program", m.pointer)
+	case usesCombo(op) && m.program[m.pointer+1] == 7:
+		return fmt.Errorf("%d: invalid combo operand 7", m.pointer+1)
+	case (op == 0 || op == 6 || op == 7) && m.combo() < 0:
+		return fmt.Errorf("%d: shift by a negative %d", m.pointer, m.combo())
+	}
+	return nil
+}
+
+// step executes the instruction at the pointer, and returns whether the
+// machine is still running afterwards. An instruction that cannot execute
+// fails, leaving the machine as it was.
+func (m *machine) step() (bool, error) {
+	if m.halted() {
+		return false, nil
+	}
+	if err := m.check(); err != nil {
+		return false, err
+	}
+	switch {
+	case m.program[m.pointer] == 0:
+		m.adv()
+	case m.program[m.pointer] == 1:
+		m.bxl()
+	case m.program[m.pointer] == 2:
+		m.bst()
+	case m.program[m.pointer] == 3:
+		m.jnz()
+	case m.program[m.pointer] == 4:
+		m.bxc()
+	case m.program[m.pointer] == 5:
+		m.out()
+	case m.program[m.pointer] == 6:
+		m.bdv()
+	case m.program[m.pointer] == 7:
+		m.cdv()
+	}
+	return !m.halted(), nil
+}
+
+func (m machine) printOutput() string {
+	return join(m.output)
+}
+
+// join formats values the way the machine's output is given.
+func join(values []int) string {
+	vs := make([]string, len(values))
+	for i, v := range values {
+		vs[i] = strconv.Itoa(v)
+	}
+	return strings.Join(vs, ",")
+}
+
+func (m machine) registers() [3]int {
+	return [3]int{m.a, m.b, m.c}
+}
+
+// register returns the register of the given index: 0 for A, 1 for B and 2
+// for C.
+func (m *machine) register(i int) *int {
+	return []*int{&m.a, &m.b, &m.c}[i]
+}
@@ -0,0 +1,51 @@
+package day17
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// floatDivide is how adv, bdv and cdv used to divide A by 2 to the power of
+// their operand: through float64, which is only exact for A below 2^53.
+func floatDivide(a, shift int) int {
+	return int(math.Trunc(float64(a) / math.Pow(2, float64(shift))))
+}
+
+// divide runs the division instruction of the given opcode, shifting A by the
+// value of register B, and returns the register it wrote.
+func divide(opcode, a, shift int) int {
+	m := machine{a: a, b: shift, program: []int{opcode, 5}}
+	if _, err := m.step(); err != nil {
+		panic(err)
+	}
+	switch opcode {
+	case 0:
+		return m.a
+	case 6:
+		return m.b
+	}
+	return m.c
+}
+
+func TestDivide(t *testing.T) {
+	rng := rand.New(rand.NewPCG(17, 2024))
+	for range 10000 {
+		a, shift := rng.IntN(1<<53), rng.IntN(70)
+		for _, opcode := range []int{0, 6, 7} {
+			if got, want := divide(opcode, a, shift), floatDivide(a, shift); got != want {
+				t.Fatalf("opcode %d: %d / 2^%d = %d, want %d as through float64", opcode, a, shift, got, want)
+			}
+		}
+	}
+	// Above 2^53, float64 rounds A before dividing: 2^54+3 becomes 2^54+4.
+	a := 1<<54 + 3
+	for _, opcode := range []int{0, 6, 7} {
+		if got, want := divide(opcode, a, 1), 1<<53+1; got != want {
+			t.Errorf("opcode %d: %d / 2 = %d, want %d", opcode, a, got, want)
+		}
+	}
+	if floatDivide(a, 1) == 1<<53+1 {
+		t.Errorf("float64 divided %d exactly, so this test shows nothing", a)
+	}
+}
@@ -0,0 +1,199 @@
+package day17
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// loop describes the shape of a program that part 2 can be solved for: a
+// single loop that ends with jnz 0, shifts A right by a constant number of
+// bits per iteration, outputs once per iteration, and computes B and C anew
+// from A in every iteration.
+type loop struct {
+	// shift is the number of bits of A consumed per iteration.
+	shift int
+	// window is the number of low bits of A, at the start of an iteration,
+	// that the iteration's output depends on.
+	window int
+}
+
+// unbounded marks a dependency on all the bits of A.
+const unbounded = math.MaxInt32
+
+// value is what is known of a register's value within an iteration: its low m
+// bits depend only on the bits of A (at the start of the iteration) below
+// max(dep, shifted+m), and it fits in width bits.
+type value struct {
+	dep, shifted, width int
+}
+
+func (v value) bits(m int) int {
+	return min(max(v.dep, v.shifted+m), unbounded)
+}
+
+// analyze checks that the program has the shape of a loop, and works out how
+// many bits of A it consumes per iteration, and how many the outputs depend on.
+func analyze(program []int) (loop, error) {
+	n := len(program)
+	if n < 2 || n%2 != 0 {
+		return loop{}, errors.New("program has a dangling opcode")
+	}
+	if program[n-2] != 3 || program[n-1] != 0 {
+		return loop{}, errors.New("program does not end with jnz 0")
+	}
+	l := loop{}
+	consumed, outs, advs := 0, 0, 0
+	regs := map[int]value{}
+	// read returns what is known of the combo operand: A read after the shifts
+	// so far, or a register set earlier in the iteration.
+	read := func(p, op int) (value, error) {
+		switch {
+		case op < 4:
+			return value{width: bitLen(op), shifted: -unbounded}, nil
+		case op == 4:
+			return value{shifted: consumed, width: unbounded}, nil
+		case op == 7:
+			return value{}, fmt.Errorf("%d: invalid combo operand 7", p)
+		}
+		v, ok := regs[op]
+		if !ok {
+			return value{}, fmt.Errorf("%d: %c is read before it is set, so it carries over between iterations", p, 'A'+op-4)
+		}
+		return v, nil
+	}
+	for p := 0; p < n-2; p += 2 {
+		op, arg := program[p], program[p+1]
+		var err error
+		var v, w value
+		switch op {
+		case 0: // adv
+			if arg > 3 {
+				return loop{}, fmt.Errorf("%d: A is shifted by a register, not a constant", p)
+			}
+			consumed += arg
+			advs++
+		case 1: // bxl
+			if v, err = read(p, 5); err == nil {
+				regs[5] = xor(v, value{width: bitLen(arg), shifted: -unbounded})
+			}
+		case 2: // bst
+			if v, err = read(p, arg); err == nil {
+				regs[5] = value{dep: v.bits(3), shifted: -unbounded, width: 3}
+			}
+		case 3: // jnz
+			return loop{}, fmt.Errorf("%d: jumps other than the final jnz 0", p)
+		case 4: // bxc
+			if v, err = read(p, 5); err == nil {
+				if w, err = read(p, 6); err == nil {
+					regs[5] = xor(v, w)
+				}
+			}
+		case 5: // out
+			if v, err = read(p, arg); err == nil {
+				l.window = max(l.window, v.bits(3))
+				outs++
+			}
+		case 6, 7: // bdv, cdv
+			if v, err = read(p, arg); err == nil {
+				if v.width >= 6 {
+					return loop{}, fmt.Errorf("%d: A is shifted by an unbounded amount", p)
+				}
+				maxShift := 1<<v.width - 1
+				regs[op-1] = value{dep: v.bits(v.width), shifted: consumed + maxShift, width: unbounded}
+			}
+		}
+		if err != nil {
+			return loop{}, err
+		}
+	}
+	switch {
+	case advs != 1 || consumed == 0:
+		return loop{}, errors.New("A is not shifted exactly once per iteration")
+	case outs != 1:
+		return loop{}, fmt.Errorf("%d outputs per iteration, not 1", outs)
+	case l.window >= unbounded:
+		return loop{}, errors.New("output depends on unboundedly many bits of A")
+	}
+	l.shift = consumed
+	return l, nil
+}
+
+func xor(v, w value) value {
+	return value{dep: max(v.dep, w.dep), shifted: max(v.shifted, w.shifted), width: max(v.width, w.width)}
+}
+
+func bitLen(n int) int {
+	b := 0
+	for ; n > 0; n >>= 1 {
+		b++
+	}
+	return b
+}
+
+// quine returns the lowest positive value of A for which the machine outputs
+// its own program.
+func quine(m machine) (int, error) {
+	return m.search(m.program)
+}
+
+// search returns the lowest positive value of A for which the machine outputs
+// exactly the target.
+//
+// Each iteration outputs a value that depends only on A at its start, which
+// is A at the start of the next iteration shifted back left, with shift new
+// low bits. So A is built from the last output backwards: breadth first, each
+// level extends the candidates of the previous one by every combination of
+// new low bits, keeping those whose first output matches the target.
+func (m machine) search(target []int) (int, error) {
+	l, err := analyze(m.program)
+	if err != nil {
+		return 0, fmt.Errorf("program is not a loop the search understands: %w", err)
+	}
+	if l.shift*len(target) >= 63 || l.window >= 32 {
+		return 0, fmt.Errorf("A would need %d bits, and outputs depend on %d of them", l.shift*len(target), l.window)
+	}
+	prog, err := compile(m.program)
+	if err != nil {
+		return 0, err
+	}
+	// The output only depends on the low window bits of A, so runs are shared
+	// between the candidates that agree on those.
+	outputs := map[int]int{}
+	mask := 1<<l.window - 1
+	candidates := []int{0}
+	for i := len(target) - 1; i >= 0; i-- {
+		var next []int
+		for _, c := range candidates {
+			for d := 0; d < 1<<l.shift; d++ {
+				a := c<<l.shift | d
+				if a == 0 {
+					continue
+				}
+				v, ok := outputs[a&mask]
+				if !ok {
+					v = prog.run(a, m.b, m.c, 1)[0]
+					outputs[a&mask] = v
+				}
+				if v == target[i] {
+					next = append(next, a)
+				}
+			}
+		}
+		if len(next) == 0 {
+			return 0, fmt.Errorf("no value of A makes the program output %s", join(target))
+		}
+		candidates = next
+	}
+	a := slices.Min(candidates)
+	mc := m.copy()
+	mc.a = a
+	if err := mc.run(); err != nil {
+		return 0, err
+	}
+	if !slices.Equal(mc.output, target) {
+		return 0, fmt.Errorf("A = %d outputs %s, not %s", a, mc.printOutput(), join(target))
+	}
+	return a, nil
+}
@@ -0,0 +1,108 @@
+package day17
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// generate returns a random program of the shape the puzzle's inputs have:
+// B is set from the low bits of A, mixed with constants and with C (A shifted
+// by B), and output, with A shifted by k somewhere in the loop.
+func generate(rng *rand.Rand, k int) []int {
+	body := [][]int{{2, 4}, {1, rng.IntN(8)}, {7, 5}, {4, rng.IntN(8)}, {1, rng.IntN(8)}, {5, 5}}
+	if rng.IntN(2) == 0 {
+		body[len(body)-1] = []int{5, 6}
+	}
+	body = slices.Insert(body, rng.IntN(len(body)+1), []int{0, k})
+	return append(slices.Concat(body...), 3, 0)
+}
+
+// bruteSearch tries every A that outputs as many values as the target has.
+func bruteSearch(program []int, k int, target []int) (int, bool) {
+	for a := 1; a < 1<<(k*len(target)); a++ {
+		m := machine{a: a, program: program}
+		if err := m.run(); err != nil {
+			panic(err)
+		}
+		if slices.Equal(m.output, target) {
+			return a, true
+		}
+	}
+	return 0, false
+}
+
+func TestSearchGenerated(t *testing.T) {
+	rng := rand.New(rand.NewPCG(17, 2024))
+	found, missing := 0, 0
+	for i := range 60 {
+		k := 1 + i%3
+		program := generate(rng, k)
+		// Half of the targets are outputs of the program, which have a
+		// solution, and half are random, which mostly do not.
+		var target []int
+		if i%2 == 0 {
+			m := machine{a: 1 + rng.IntN(1<<12), program: program}
+			if err := m.run(); err != nil {
+				t.Fatal(err)
+			}
+			target = m.output
+		} else {
+			for range 12 / k {
+				target = append(target, rng.IntN(8))
+			}
+		}
+		want, ok := bruteSearch(program, k, target)
+		got, err := machine{program: program}.search(target)
+		switch {
+		case !ok && err == nil:
+			t.Errorf("%v: search(%v) = %d, want an error", program, target, got)
+		case ok && err != nil:
+			t.Errorf("%v: search(%v) failed: %v, want %d", program, target, err, want)
+		case ok && got != want:
+			t.Errorf("%v: search(%v) = %d, want %d", program, target, got, want)
+		}
+		if ok {
+			found++
+		} else {
+			missing++
+		}
+	}
+	if found == 0 || missing == 0 {
+		t.Errorf("generated %d targets with a solution and %d without, want some of both", found, missing)
+	}
+}
+
+func TestQuine(t *testing.T) {
+	got, err := quine(machine{a: 2024, program: []int{0, 3, 5, 4, 3, 0}})
+	if err != nil || got != 117440 {
+		t.Errorf("quine() = %d, %v, want 117440", got, err)
+	}
+	_, err = quine(machine{a: 729, program: []int{0, 1, 5, 4, 3, 0}})
+	if err == nil || !strings.Contains(err.Error(), "no value of A makes the program output 0,1,5,4,3,0") {
+		t.Errorf("quine() = %v, want an error saying there is no solution", err)
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	l, err := analyze([]int{2, 4, 1, 2, 7, 5, 4, 1, 1, 3, 5, 5, 0, 3, 3, 0})
+	if err != nil || l != (loop{shift: 3, window: 10}) {
+		t.Errorf("analyze() = %+v, %v, want shift 3 and window 10", l, err)
+	}
+	for _, tc := range []struct {
+		program []int
+		err     string
+	}{
+		{[]int{2, 4, 0, 3, 5, 5}, "does not end with jnz 0"},
+		{[]int{2, 4, 0, 5, 5, 5, 3, 0}, "shifted by a register"},
+		{[]int{2, 4, 0, 3, 5, 5, 5, 4, 3, 0}, "2 outputs per iteration"},
+		{[]int{1, 3, 0, 3, 5, 5, 3, 0}, "B is read before it is set"},
+		{[]int{2, 4, 3, 4, 0, 3, 5, 5, 3, 0}, "jumps other than"},
+		{[]int{2, 4, 5, 5, 3, 0}, "not shifted exactly once"},
+	} {
+		if _, err := analyze(tc.program); err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("analyze(%v) = %v, want an error containing %q", tc.program, err, tc.err)
+		}
+	}
+}
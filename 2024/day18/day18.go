@@ -0,0 +1,111 @@
+package day18
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/grid"
+	"github.com/liviro/aoc/internal/parse"
+	"github.com/liviro/aoc/internal/search"
+)
+
+// The ways in which the exit can stay out of reach, or not.
+var (
+	errNoPath    = errors.New("no path to the exit")
+	errNoBlocker = errors.New("no byte cuts off the exit")
+)
+
+func shortestPath(bytes []grid.Point, size, after int) (int, error) {
+	maxCoord := grid.Point{X: size, Y: size}
+	corrupted := grid.New[bool](size+1, size+1)
+	for i := 0; i < after; i++ {
+		corrupted.Set(bytes[i], true)
+	}
+
+	res := search.BFS(grid.Point{X: 0, Y: 0}, func(n grid.Point) []grid.Point {
+		var ns []grid.Point
+		for _, nc := range corrupted.Neighbors4(n) {
+			// Ignore corrupted
+			if !corrupted.At(nc) {
+				ns = append(ns, nc)
+			}
+		}
+		return ns
+	}, func(n grid.Point) bool {
+		return n == maxCoord
+	})
+	if d, ok := res.Dist[maxCoord]; ok {
+		return d, nil
+	}
+	return 0, errNoPath
+}
+
+func blocker(bytes []grid.Point, size int) (grid.Point, error) {
+	for i := 1; i <= len(bytes); i++ {
+		if _, err := shortestPath(bytes, size, i); err != nil {
+			return bytes[i-1], nil
+		}
+	}
+	return grid.Point{}, errNoBlocker
+}
+
+func extractBytes(r io.Reader, size int) ([]grid.Point, error) {
+	ls, err := parse.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+	bs := []grid.Point{}
+	for _, l := range ls {
+		b := grid.Point{}
+		if err := parse.Match(l, "%d,%d", &b.X, &b.Y); err != nil {
+			return nil, err
+		}
+		if b.X < 0 || b.Y < 0 || b.X > size || b.Y > size {
+			return nil, l.Errorf("byte %v falls outside of the memory space", b)
+		}
+		bs = append(bs, b)
+	}
+	return bs, nil
+}
+
+func init() {
+	aoc.Register(2024, 18, func() aoc.Solver { return &solver{size: 70, fallen: 1024} })
+}
+
+type solver struct {
+	size, fallen int
+	bytes        []grid.Point
+}
+
+func (s *solver) Params() []aoc.Param {
+	return []aoc.Param{
+		{Name: "size", Usage: "largest coordinate of the memory space", Value: &s.size},
+		{Name: "fallen", Usage: "bytes fallen before part 1's path is found", Value: &s.fallen},
+	}
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.bytes, err = extractBytes(r, s.size)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	if s.fallen > len(s.bytes) {
+		return aoc.Answer{}, fmt.Errorf("only %d bytes fall, not %d", len(s.bytes), s.fallen)
+	}
+	d, err := shortestPath(s.bytes, s.size, s.fallen)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Int(d), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	b, err := blocker(s.bytes, s.size)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Text(fmt.Sprintf("%d,%d", b.X, b.Y)), nil
+}
@@ -0,0 +1,32 @@
+package day18
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNoPath(t *testing.T) {
+	// The first two bytes leave a way through the middle, and the third walls
+	// the exit into its corner.
+	s := &solver{size: 2, fallen: 2}
+	if err := s.Parse(strings.NewReader("0,1\n2,1\n1,2\n")); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := s.Part2(); err != nil || got.String() != "1,2" {
+		t.Errorf("Part2() = %v, %v, want 1,2", got, err)
+	}
+	s.fallen = 3
+	if _, err := s.Part1(); err != errNoPath {
+		t.Errorf("Part1() = %v, want %v", err, errNoPath)
+	}
+}
+
+func TestNoBlocker(t *testing.T) {
+	s := &solver{size: 2, fallen: 1}
+	if err := s.Parse(strings.NewReader("0,1\n2,1\n")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Part2(); err != errNoBlocker {
+		t.Errorf("Part2() = %v, want %v", err, errNoBlocker)
+	}
+}
@@ -0,0 +1,81 @@
+package day19
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
+)
+
+func extractTowels(r io.Reader) ([]string, []string, error) {
+	sections, err := parse.Sections(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(sections) != 2 || len(sections[0]) != 1 {
+		return nil, nil, errors.New("expected a line of patterns, a blank line and designs")
+	}
+	patterns := strings.Split(sections[0][0].Text, ", ")
+	designs := []string{}
+	for _, l := range sections[1] {
+		designs = append(designs, l.Text)
+	}
+	return patterns, designs, nil
+}
+
+func possibilities(design string, patterns []string, memo map[string]int) int {
+	if poss, ok := memo[design]; ok {
+		return poss
+	}
+	c := 0
+	for _, p := range patterns {
+		if strings.HasPrefix(design, p) {
+			if len(design) == len(p) {
+				c++
+				continue
+			}
+			nd := strings.Clone(design)
+			c += possibilities(nd[len(p):], patterns, memo)
+		}
+	}
+	memo[design] = c
+	return c
+}
+
+func solve(designs []string, patterns []string) (int, int) {
+	p1 := 0
+	p2 := 0
+	memo := map[string]int{}
+	for _, d := range designs {
+		if ps := possibilities(d, patterns, memo); ps > 0 {
+			p1++
+			p2 += ps
+		}
+	}
+	return p1, p2
+}
+
+func init() {
+	aoc.Register(2024, 19, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	patterns, designs []string
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.patterns, s.designs, err = extractTowels(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	p1, _ := solve(s.designs, s.patterns)
+	return aoc.Int(p1), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	_, p2 := solve(s.designs, s.patterns)
+	return aoc.Int(p2), nil
+}
@@ -0,0 +1,94 @@
+package day20
+
+import (
+	"errors"
+	"io"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/grid"
+	"github.com/liviro/aoc/internal/search"
+)
+
+func extractMaze(r io.Reader) (*grid.Grid[rune], grid.Point, grid.Point, error) {
+	m, err := grid.Read(r, grid.RunesOf(".#SE"))
+	if err != nil {
+		return nil, grid.Point{}, grid.Point{}, err
+	}
+	start, ok := m.Find(func(v rune) bool { return v == 'S' })
+	if !ok {
+		return nil, grid.Point{}, grid.Point{}, errors.New("no start in maze")
+	}
+	end, ok := m.Find(func(v rune) bool { return v == 'E' })
+	if !ok {
+		return nil, grid.Point{}, grid.Point{}, errors.New("no end in maze")
+	}
+	return m, start, end, nil
+}
+
+func shortestPath(m *grid.Grid[rune], start, end grid.Point) map[grid.Point]int {
+	res := search.BFS(start, func(n grid.Point) []grid.Point {
+		var ns []grid.Point
+		for _, nc := range m.Neighbors4(n) {
+			// Ignore walls
+			if m.At(nc) != '#' {
+				ns = append(ns, nc)
+			}
+		}
+		return ns
+	}, func(n grid.Point) bool {
+		return n == end
+	})
+
+	bestPath := map[grid.Point]int{}
+	for _, c := range res.Path(end) {
+		bestPath[c] = res.Dist[c]
+	}
+	return bestPath
+}
+
+func savingCheats(m *grid.Grid[rune], start, end grid.Point, cheatSize, minSaving int) int {
+	cheatsOver := 0
+	sp := shortestPath(m, start, end)
+
+	for c1, v1 := range sp {
+		for c2, v2 := range sp {
+			if c1.Manhattan(c2) <= cheatSize {
+				newDist := v1 + c1.Manhattan(c2) + (sp[end] - v2)
+				if sp[end]-newDist >= minSaving {
+					cheatsOver++
+				}
+			}
+		}
+	}
+
+	return cheatsOver
+}
+
+func init() {
+	aoc.Register(2024, 20, func() aoc.Solver { return &solver{saving: 100} })
+}
+
+type solver struct {
+	saving     int
+	m          *grid.Grid[rune]
+	start, end grid.Point
+}
+
+func (s *solver) Params() []aoc.Param {
+	return []aoc.Param{
+		{Name: "saving", Usage: "picoseconds a cheat must save at least to count", Value: &s.saving},
+	}
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.m, s.start, s.end, err = extractMaze(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(savingCheats(s.m, s.start, s.end, 2, s.saving)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(savingCheats(s.m, s.start, s.end, 20, s.saving)), nil
+}
@@ -1,17 +1,21 @@
-package main
+package day21
 
 import (
-	"bufio"
-	"fmt"
-	"os"
+	"io"
 	"strings"
-	"time"
 
-	"github.com/liviro/aoc/2024/internal/parse"
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
 )
 
 type coord struct{ x, y int }
 
+// code is a door code, along with its numeric part.
+type code struct {
+	keys  string
+	value int
+}
+
 var keypad = map[string]coord{
 	"7": {0, 0}, "8": {1, 0}, "9": {2, 0},
 	"4": {0, 1}, "5": {1, 1}, "6": {2, 1},
@@ -88,34 +92,49 @@ func presses(seq string, depth int, memo map[int]map[string]int) int {
 	return l
 }
 
-func complexity(codes []string, numDirpads int) int {
+func complexity(codes []code, numDirpads int) int {
 	s := 0
 	memo := map[int]map[string]int{}
 	for _, c := range codes {
-		cts := codeToSequence(c, numDirpads, memo)
-		s += cts * parse.MustInt(c[:3])
+		cts := codeToSequence(c.keys, numDirpads, memo)
+		s += cts * c.value
 	}
 	return s
 }
 
-func extractCodes(name string) []string {
-	fp, err := os.Open(name)
+func extractCodes(r io.Reader) ([]code, error) {
+	ls, err := parse.Lines(r)
 	if err != nil {
-		panic("Unable to open file")
+		return nil, err
 	}
-	defer fp.Close()
-	s := bufio.NewScanner(fp)
-	cs := []string{}
-	for s.Scan() {
-		cs = append(cs, s.Text())
+	cs := []code{}
+	for _, l := range ls {
+		c := code{keys: l.Text}
+		if err := parse.Match(l, "%dA", &c.value); err != nil {
+			return nil, err
+		}
+		cs = append(cs, c)
 	}
-	return cs
+	return cs, nil
+}
+
+func init() {
+	aoc.Register(2024, 21, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	codes []code
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.codes, err = extractCodes(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(complexity(s.codes, 2)), nil
 }
 
-func main() {
-	t := time.Now()
-	codes := extractCodes(os.Args[1])
-	fmt.Printf("Part 1: %d\n", complexity(codes, 2))
-	fmt.Printf("Part 2: %d\n", complexity(codes, 25))
-	fmt.Printf("Time elapsed: %s\n", time.Since(t))
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(complexity(s.codes, 25)), nil
 }
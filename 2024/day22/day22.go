@@ -1,12 +1,11 @@
-package main
+package day22
 
 import (
-	"bufio"
 	"fmt"
-	"os"
-	"time"
+	"io"
 
-	"github.com/liviro/aoc/2024/internal/parse"
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
 )
 
 type secret struct {
@@ -15,22 +14,24 @@ type secret struct {
 	changes []int
 }
 
-func extractSecrets(name string) []secret {
-	fp, err := os.Open(name)
+func extractSecrets(r io.Reader) ([]secret, error) {
+	ls, err := parse.Lines(r)
 	if err != nil {
-		panic("Unable to open file")
+		return nil, err
 	}
-	defer fp.Close()
-	s := bufio.NewScanner(fp)
 	scs := []secret{}
-	for s.Scan() {
+	for _, l := range ls {
+		v, err := l.Int()
+		if err != nil {
+			return nil, err
+		}
 		scs = append(scs, secret{
-			val:     parse.MustInt(s.Text()),
+			val:     v,
 			history: []int{},
 			changes: []int{},
 		})
 	}
-	return scs
+	return scs, nil
 }
 
 func (s *secret) evolve() {
@@ -116,10 +117,23 @@ func part2(scs []secret) int {
 	return maxBananas
 }
 
-func main() {
-	t := time.Now()
-	secrets := extractSecrets(os.Args[1])
-	fmt.Printf("Part1 : %d\n", part1(secrets))
-	fmt.Printf("Part2 : %d\n", part2(secrets))
-	fmt.Printf("Time elapsed: %s\n", time.Since(t))
+func init() {
+	aoc.Register(2024, 22, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	secrets []secret
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.secrets, err = extractSecrets(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(part1(s.secrets)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(part2(s.secrets)), nil
 }
@@ -1,21 +1,16 @@
-package main
+package day23
 
 import (
 	"bufio"
-	"fmt"
-	"os"
+	"io"
 	"slices"
 	"strings"
-	"time"
+
+	"github.com/liviro/aoc/internal/aoc"
 )
 
-func extractConns(name string) map[string][]string {
-	fp, err := os.Open(name)
-	if err != nil {
-		panic("Unable to open file")
-	}
-	defer fp.Close()
-	s := bufio.NewScanner(fp)
+func extractConns(r io.Reader) map[string][]string {
+	s := bufio.NewScanner(r)
 	conns := map[string][]string{}
 	for s.Scan() {
 		computers := strings.Split(s.Text(), "-")
@@ -121,11 +116,23 @@ func part2(clusters [][]string) string {
 	return strings.Join(biggest, ",")
 }
 
-func main() {
-	t := time.Now()
-	connections := extractConns(os.Args[1])
-	clusters := cluster3(connections)
-	fmt.Printf("Part1 : %d\n", part1(clusters))
-	fmt.Printf("Part2 : %s\n", part2(clusterBig(connections)))
-	fmt.Printf("Time elapsed: %s\n", time.Since(t))
+func init() {
+	aoc.Register(2024, 23, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	conns map[string][]string
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	s.conns = extractConns(r)
+	return nil
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(part1(cluster3(s.conns))), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Text(part2(clusterBig(s.conns))), nil
 }
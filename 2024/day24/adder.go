@@ -0,0 +1,228 @@
+package day24
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// finding is a place where the circuit departs from a ripple-carry adder,
+// along with the swap of two gates' outputs that repairs it.
+type finding struct {
+	bit    int
+	a, b   string
+	reason string
+}
+
+func (f finding) String() string {
+	return fmt.Sprintf("bit %d: %s; swap %s and %s", f.bit, f.reason, f.a, f.b)
+}
+
+// adder matches a circuit against a ripple-carry adder built of full adders,
+// one per bit of the inputs:
+//
+//	sum   = x XOR y        half = x AND y
+//	z     = sum XOR carry  over = sum AND carry
+//	carry' = over OR half
+//
+// where bit 0 is a half adder, without an incoming carry, and the last carry
+// is the top output bit. Gates are found by their inputs, which swapping
+// outputs does not change, and referred to by index.
+type adder struct {
+	rules    []rule
+	producer map[string]int   // Gate outputting each wire.
+	users    map[string][]int // Gates taking each wire as an input.
+	findings []finding
+}
+
+func newAdder(rules []rule) *adder {
+	a := &adder{rules: slices.Clone(rules), producer: map[string]int{}, users: map[string][]int{}}
+	for i, r := range a.rules {
+		a.producer[r.out] = i
+		a.users[r.in1] = append(a.users[r.in1], i)
+		a.users[r.in2] = append(a.users[r.in2], i)
+	}
+	return a
+}
+
+// width returns the number of bits of the x inputs, which the y inputs must
+// match.
+func width(wires map[string]int) (int, error) {
+	n := 0
+	for bit := 0; ; bit++ {
+		_, x := wires[input('x', bit)]
+		_, y := wires[input('y', bit)]
+		if x != y {
+			return 0, fmt.Errorf("bit %d has only one of x and y", bit)
+		}
+		if !x {
+			break
+		}
+		n++
+	}
+	if n != len(wires)/2 || len(wires)%2 != 0 {
+		return 0, fmt.Errorf("%d wires are set, not x and y numbered 0 to %d", len(wires), n-1)
+	}
+	return n, nil
+}
+
+func input(name byte, bit int) string {
+	return fmt.Sprintf("%c%02d", name, bit)
+}
+
+// analyzeAdder walks the circuit bit by bit, from the lowest, following the
+// carry, and repairs each departure from a ripple-carry adder as it is found
+// so that the walk can go on. It returns the findings, in order of bits.
+func analyzeAdder(rules []rule, bits int) ([]finding, error) {
+	a := newAdder(rules)
+	carry := -1
+	for bit := range bits {
+		x, y := input('x', bit), input('y', bit)
+		sum, err := a.gate("XOR", x, y)
+		if err != nil {
+			return nil, err
+		}
+		half, err := a.gate("AND", x, y)
+		if err != nil {
+			return nil, err
+		}
+		if bit == 0 {
+			if err := a.output(bit, sum, "the sum of x00 and y00"); err != nil {
+				return nil, err
+			}
+			carry = half
+			continue
+		}
+		z, err := a.match(bit, "XOR", sum, "sum", carry, "carry")
+		if err != nil {
+			return nil, err
+		}
+		if err := a.output(bit, z, "the sum with the carry"); err != nil {
+			return nil, err
+		}
+		over, err := a.match(bit, "AND", sum, "sum", carry, "carry")
+		if err != nil {
+			return nil, err
+		}
+		if carry, err = a.match(bit, "OR", over, "overflow", half, "x AND y"); err != nil {
+			return nil, err
+		}
+	}
+	if err := a.output(bits, carry, "the last carry"); err != nil {
+		return nil, err
+	}
+	return a.findings, nil
+}
+
+// gate returns the gate combining the two wires.
+func (a *adder) gate(op, in1, in2 string) (int, error) {
+	for _, g := range a.users[in1] {
+		if r := a.rules[g]; r.op == op && (r.in1 == in2 || r.in2 == in2) {
+			return g, nil
+		}
+	}
+	return 0, fmt.Errorf("no %s gate combines %s and %s", op, in1, in2)
+}
+
+// match returns the gate combining the outputs of gates p and q, named by
+// their role in the adder. If there is none, but one of the outputs goes into
+// such a gate along with some other wire, that wire and the other output are
+// swapped.
+func (a *adder) match(bit int, op string, p int, pRole string, q int, qRole string) (int, error) {
+	if g, err := a.gate(op, a.rules[p].out, a.rules[q].out); err == nil {
+		return g, nil
+	}
+	gp, gq := a.using(op, p), a.using(op, q)
+	switch {
+	case len(gp) == 1 && len(gq) == 0:
+		p, pRole, q, qRole, gq = q, qRole, p, pRole, gp
+	case len(gq) != 1 || len(gp) != 0:
+		return 0, fmt.Errorf("bit %d: cannot tell whether the %s %s or the %s %s is wrong: %d and %d %s gates take them",
+			bit, pRole, a.rules[p].out, qRole, a.rules[q].out, len(gp), len(gq), op)
+	}
+	// Only q's output goes into an op gate, so p's is the one that is wrong.
+	r := a.rules[gq[0]]
+	other := r.in1
+	if other == a.rules[q].out {
+		other = r.in2
+	}
+	reason := fmt.Sprintf("the %s %s goes into %s %s %s -> %s rather than with the %s %s",
+		qRole, a.rules[q].out, r.in1, r.op, r.in2, r.out, pRole, a.rules[p].out)
+	if err := a.swap(bit, p, other, reason); err != nil {
+		return 0, err
+	}
+	return gq[0], nil
+}
+
+// using returns the gates of the given op taking the output of gate g.
+func (a *adder) using(op string, g int) []int {
+	var gs []int
+	for _, u := range a.users[a.rules[g].out] {
+		if a.rules[u].op == op {
+			gs = append(gs, u)
+		}
+	}
+	return gs
+}
+
+// output checks that gate g outputs the given bit of z, and otherwise swaps
+// its output with that of the gate that does.
+func (a *adder) output(bit, g int, what string) error {
+	z := input('z', bit)
+	if a.rules[g].out == z {
+		return nil
+	}
+	return a.swap(bit, g, z, fmt.Sprintf("%s is output to %s rather than %s", what, a.rules[g].out, z))
+}
+
+// swap swaps the output of gate g with that of the gate outputting wire w.
+func (a *adder) swap(bit, g int, w, reason string) error {
+	h, ok := a.producer[w]
+	if !ok {
+		return fmt.Errorf("bit %d: %s, but no gate outputs %s to swap with", bit, reason, w)
+	}
+	r, s := &a.rules[g], &a.rules[h]
+	a.findings = append(a.findings, finding{bit: bit, a: r.out, b: s.out, reason: reason})
+	r.out, s.out = s.out, r.out
+	a.producer[r.out], a.producer[s.out] = g, h
+	return nil
+}
+
+// repair returns the wires whose gates' outputs must be swapped for the
+// circuit to add, sorted and comma-separated, having checked that the circuit
+// with the swaps made adds each bit correctly.
+func repair(wires map[string]int, rules []rule) (string, error) {
+	bits, err := width(wires)
+	if err != nil {
+		return "", err
+	}
+	findings, err := analyzeAdder(rules, bits)
+	if err != nil {
+		return "", err
+	}
+	fixed := slices.Clone(rules)
+	var swapped []string
+	for _, f := range findings {
+		swapOutputs(fixed, f.a, f.b)
+		swapped = append(swapped, f.a, f.b)
+	}
+	if m, err := countMistakes(fixed, bits); err != nil {
+		return "", fmt.Errorf("with %d swaps made: %w", len(findings), err)
+	} else if m != 0 {
+		return "", fmt.Errorf("the circuit still makes %d mistakes with %d swaps made", m, len(findings))
+	}
+	slices.Sort(swapped)
+	return strings.Join(swapped, ","), nil
+}
+
+// swapOutputs swaps the outputs of the gates outputting wires a and b.
+func swapOutputs(rules []rule, a, b string) {
+	for i, r := range rules {
+		switch r.out {
+		case a:
+			rules[i].out = b
+		case b:
+			rules[i].out = a
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package day24
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// roles names the wires of a generated adder by bit and role.
+type roles map[string]string
+
+// generateAdder returns the inputs and gates of a correct ripple-carry adder
+// of the given width, with randomly named internal wires, shuffled, along
+// with the name of each wire by its role ("sum 3", "carry 3" and so on).
+func generateAdder(rng *rand.Rand, bits int) (map[string]int, []rule, roles) {
+	wires := map[string]int{}
+	rs := []rule{}
+	names := roles{}
+	used := map[string]bool{}
+	wire := func(role string) string {
+		for {
+			w := string([]byte{byte('a' + rng.IntN(26)), byte('a' + rng.IntN(26)), byte('a' + rng.IntN(26))})
+			if !used[w] && w[0] != 'x' && w[0] != 'y' && w[0] != 'z' {
+				used[w] = true
+				names[role] = w
+				return w
+			}
+		}
+	}
+	gate := func(in1, op, in2, out string) {
+		if rng.IntN(2) == 0 {
+			in1, in2 = in2, in1
+		}
+		rs = append(rs, rule{in1: in1, in2: in2, op: op, out: out})
+	}
+	carry := ""
+	for bit := range bits {
+		x, y, z := input('x', bit), input('y', bit), input('z', bit)
+		wires[x], wires[y] = rng.IntN(2), rng.IntN(2)
+		names[fmt.Sprint("z ", bit)] = z
+		if bit == 0 {
+			gate(x, "XOR", y, z)
+			names["sum 0"] = z
+			if carry = input('z', 1); bits > 1 {
+				carry = wire("carry 0")
+			}
+			gate(x, "AND", y, carry)
+			continue
+		}
+		sum, half := wire(fmt.Sprint("sum ", bit)), wire(fmt.Sprint("half ", bit))
+		gate(x, "XOR", y, sum)
+		gate(x, "AND", y, half)
+		gate(sum, "XOR", carry, z)
+		over := wire(fmt.Sprint("over ", bit))
+		gate(sum, "AND", carry, over)
+		out := input('z', bits)
+		if bit < bits-1 {
+			out = wire(fmt.Sprint("carry ", bit))
+		} else {
+			names[fmt.Sprint("carry ", bit)] = out
+		}
+		gate(over, "OR", half, out)
+		carry = out
+	}
+	rng.Shuffle(len(rs), func(i, j int) { rs[i], rs[j] = rs[j], rs[i] })
+	return wires, rs, names
+}
+
+func TestAnalyzeAdderCorrect(t *testing.T) {
+	rng := rand.New(rand.NewPCG(24, 2024))
+	for _, bits := range []int{1, 2, 5, 45, 60} {
+		wires, rs, _ := generateAdder(rng, bits)
+		if got, err := repair(wires, rs); err != nil || got != "" {
+			t.Errorf("%d bits: repair() = %q, %v, want no swaps", bits, got, err)
+		}
+	}
+}
+
+func TestAnalyzeAdderSwaps(t *testing.T) {
+	rng := rand.New(rand.NewPCG(24, 2025))
+	// Pairs of roles at the same bit whose outputs swapped break the adder.
+	kinds := [][2]string{
+		{"sum %d", "half %d"},
+		{"z %d", "over %d"},
+		{"z %d", "sum %d"},
+		{"z %d", "carry %d"},
+		{"z %d", "half %d"},
+	}
+	for _, bits := range []int{6, 12, 45} {
+		for trial := range 20 {
+			wires, rs, names := generateAdder(rng, bits)
+			// Swap up to four pairs, at bits at least two apart.
+			var want []string
+			for _, bit := range rng.Perm(bits / 3)[:min(1+trial%4, bits/3)] {
+				bit = 3*bit + 1
+				k := kinds[rng.IntN(len(kinds))]
+				a, b := names[fmt.Sprintf(k[0], bit)], names[fmt.Sprintf(k[1], bit)]
+				swapOutputs(rs, a, b)
+				want = append(want, a, b)
+			}
+			slices.Sort(want)
+			got, err := repair(wires, rs)
+			if err != nil || got != strings.Join(want, ",") {
+				t.Errorf("%d bits: repair() = %q, %v, want %q", bits, got, err, strings.Join(want, ","))
+			}
+		}
+	}
+}
+
+func TestAnalyzeAdderExplains(t *testing.T) {
+	wires, rs, names := generateAdder(rand.New(rand.NewPCG(1, 2)), 8)
+	swapOutputs(rs, names["z 5"], names["over 5"])
+	bits, err := width(wires)
+	if err != nil {
+		t.Fatal(err)
+	}
+	findings, err := analyzeAdder(rs, bits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := fmt.Sprintf("bit 5: the sum with the carry is output to %[1]s rather than z05; swap %[1]s and z05", names["over 5"])
+	if len(findings) != 1 || findings[0].String() != want {
+		t.Errorf("analyzeAdder() = %v, want [%s]", findings, want)
+	}
+}
+
+func TestAnalyzeAdderErrors(t *testing.T) {
+	wires, rs, _ := generateAdder(rand.New(rand.NewPCG(3, 4)), 4)
+	delete(wires, "y03")
+	if _, err := repair(wires, rs); err == nil || !strings.Contains(err.Error(), "bit 3 has only one of x and y") {
+		t.Errorf("repair() with y03 missing = %v, want an error", err)
+	}
+	wires["y03"] = 0
+	rs = slices.DeleteFunc(rs, func(r rule) bool { return r.op == "AND" && (r.in1 == "x02" || r.in2 == "x02") })
+	if _, err := repair(wires, rs); err == nil || !strings.Contains(err.Error(), "no AND gate combines x02 and y02") {
+		t.Errorf("repair() with x02 AND y02 missing = %v, want an error", err)
+	}
+}
@@ -0,0 +1,179 @@
+package day24
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// gate is a compiled rule, with wires referred to by index.
+type gate struct {
+	op            string
+	in1, in2, out int
+}
+
+// circuit is a gate network compiled for evaluation: wires are numbered, the
+// inputs first, and the gates ordered so that each comes after the gates its
+// inputs come from. Evaluating it is then a single pass over the gates.
+//
+// Values are evaluated 64 at a time, each wire's value in a lane holding bit
+// i of a uint64 for lane i.
+type circuit struct {
+	wires []string
+	index map[string]int
+	gates []gate
+	// bus holds the wires numbered from 0 for each of x, y and z.
+	bus map[byte][]int
+}
+
+// compile numbers the wires and sorts the gates. It fails if a gate input is
+// neither an input of the circuit nor output by a gate, if two gates output
+// the same wire, or if the gates form a loop, which it reports.
+func compile(inputs []string, rules []rule) (*circuit, error) {
+	c := &circuit{index: map[string]int{}, bus: map[byte][]int{}}
+	for _, w := range inputs {
+		c.wire(w)
+	}
+	producer := map[int]int{}
+	for i, r := range rules {
+		out := c.wire(r.out)
+		if out < len(inputs) {
+			return nil, fmt.Errorf("%s is both an input and output by %s %s %s", r.out, r.in1, r.op, r.in2)
+		}
+		if j, ok := producer[out]; ok {
+			return nil, fmt.Errorf("%s is output by both %s %s %s and %s %s %s", r.out, rules[j].in1, rules[j].op, rules[j].in2, r.in1, r.op, r.in2)
+		}
+		producer[out] = i
+	}
+	for _, r := range rules {
+		for _, in := range []string{r.in1, r.in2} {
+			if _, ok := c.index[in]; !ok {
+				return nil, fmt.Errorf("%s is neither an input nor output by a gate", in)
+			}
+		}
+	}
+
+	// Kahn's algorithm: a gate is ready once the gates outputting its inputs
+	// are placed.
+	waiting := make([]int, len(rules))
+	users := map[int][]int{}
+	var ready []int
+	for i, r := range rules {
+		for _, in := range []int{c.index[r.in1], c.index[r.in2]} {
+			if _, ok := producer[in]; ok {
+				waiting[i]++
+				users[in] = append(users[in], i)
+			}
+		}
+		if waiting[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
+	for len(ready) > 0 {
+		i := ready[0]
+		ready = ready[1:]
+		r := rules[i]
+		out := c.index[r.out]
+		c.gates = append(c.gates, gate{op: r.op, in1: c.index[r.in1], in2: c.index[r.in2], out: out})
+		for _, u := range users[out] {
+			if waiting[u]--; waiting[u] == 0 {
+				ready = append(ready, u)
+			}
+		}
+	}
+	if len(c.gates) < len(rules) {
+		return nil, fmt.Errorf("gates form a loop: %s", loop(rules, waiting))
+	}
+
+	for _, name := range []byte("xyz") {
+		for bit := 0; ; bit++ {
+			i, ok := c.index[input(name, bit)]
+			if !ok {
+				break
+			}
+			c.bus[name] = append(c.bus[name], i)
+		}
+	}
+	return c, nil
+}
+
+// wire returns the index of the named wire, numbering it if it is new.
+func (c *circuit) wire(name string) int {
+	if i, ok := c.index[name]; ok {
+		return i
+	}
+	c.index[name] = len(c.wires)
+	c.wires = append(c.wires, name)
+	return c.index[name]
+}
+
+// loop finds a loop among the gates that are still waiting on their inputs
+// once every other gate is placed, and returns it as the wires around it.
+// Each such gate waits on another one, so walking back from any of them
+// comes around.
+func loop(rules []rule, waiting []int) string {
+	producer := map[string]int{}
+	start := -1
+	for i, r := range rules {
+		if waiting[i] > 0 {
+			producer[r.out] = i
+			start = i
+		}
+	}
+	seen := map[int]int{}
+	var path []string
+	for g := start; ; {
+		if at, ok := seen[g]; ok {
+			path = path[at:]
+			break
+		}
+		seen[g] = len(path)
+		path = append(path, rules[g].out)
+		if p, ok := producer[rules[g].in1]; ok {
+			g = p
+		} else {
+			g = producer[rules[g].in2]
+		}
+	}
+	// The walk went backwards, against the flow of values.
+	slices.Reverse(path)
+	return strings.Join(append(path, path[0]), " -> ")
+}
+
+// values returns room for a value per wire, all zero.
+func (c *circuit) values() []uint64 {
+	return make([]uint64, len(c.wires))
+}
+
+// eval sets the value of every gate's output, from the values of the inputs.
+func (c *circuit) eval(v []uint64) {
+	for _, g := range c.gates {
+		switch g.op {
+		case "AND":
+			v[g.out] = v[g.in1] & v[g.in2]
+		case "OR":
+			v[g.out] = v[g.in1] | v[g.in2]
+		case "XOR":
+			v[g.out] = v[g.in1] ^ v[g.in2]
+		default:
+			panic("unknown gate " + g.op)
+		}
+	}
+}
+
+// set sets the wires of the bus, x or y, to the bits of n in the given lane.
+func (c *circuit) set(v []uint64, name byte, lane, n int) {
+	for bit, w := range c.bus[name] {
+		v[w] &^= 1 << lane
+		v[w] |= uint64(n>>bit&1) << lane
+	}
+}
+
+// get returns the number on the bus, usually z, in the given lane.
+func (c *circuit) get(v []uint64, name byte, lane int) int {
+	n := 0
+	for bit, w := range c.bus[name] {
+		n |= int(v[w]>>lane&1) << bit
+	}
+	return n
+}
@@ -0,0 +1,53 @@
+package day24
+
+import (
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+func TestCircuitAdds(t *testing.T) {
+	rng := rand.New(rand.NewPCG(16, 2024))
+	const bits = 45
+	_, rs, _ := generateAdder(rng, bits)
+	c, err := compile(inputs(bits), rs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := c.values()
+	var xs, ys [64]int
+	for lane := range 64 {
+		xs[lane], ys[lane] = rng.IntN(1<<bits), rng.IntN(1<<bits)
+		c.set(v, 'x', lane, xs[lane])
+		c.set(v, 'y', lane, ys[lane])
+	}
+	c.eval(v)
+	for lane := range 64 {
+		if got := c.get(v, 'z', lane); got != xs[lane]+ys[lane] {
+			t.Errorf("lane %d: %d + %d = %d", lane, xs[lane], ys[lane], got)
+		}
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	_, rs, names := generateAdder(rand.New(rand.NewPCG(7, 8)), 4)
+	// The sum of bit 2 then feeds the gate that outputs it.
+	swapOutputs(rs, names["z 2"], names["sum 2"])
+	_, err := compile(inputs(4), rs)
+	want := "gates form a loop: " + names["sum 2"] + " -> " + names["sum 2"]
+	if err == nil || err.Error() != want {
+		t.Errorf("compile() = %v, want %q", err, want)
+	}
+	for _, tc := range []struct {
+		rules []rule
+		err   string
+	}{
+		{[]rule{{"x00", "y00", "z00", "AND"}, {"x00", "w", "z01", "OR"}}, "w is neither an input nor output by a gate"},
+		{[]rule{{"x00", "y00", "z00", "AND"}, {"x00", "y00", "z00", "OR"}}, "z00 is output by both x00 AND y00 and x00 OR y00"},
+		{[]rule{{"x00", "b", "a", "AND"}, {"a", "c", "b", "OR"}, {"b", "y00", "c", "XOR"}}, "gates form a loop: a -> b -> a"},
+	} {
+		if _, err := compile(inputs(1), tc.rules); err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("compile(%v) = %v, want an error containing %q", tc.rules, err, tc.err)
+		}
+	}
+}
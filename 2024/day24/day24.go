@@ -0,0 +1,139 @@
+package day24
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
+)
+
+type rule struct {
+	in1, in2 string
+	out      string
+	op       string
+}
+
+func extractInput(r io.Reader) (map[string]int, []rule, error) {
+	sections, err := parse.Sections(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(sections) != 2 {
+		return nil, nil, fmt.Errorf("got %d sections, want wires and rules", len(sections))
+	}
+
+	wires := map[string]int{}
+	for _, l := range sections[0] {
+		var reg string
+		var val int
+		if err := parse.Match(l, "%s: %d", &reg, &val); err != nil {
+			return nil, nil, err
+		}
+		wires[reg] = val
+	}
+
+	rules := []rule{}
+	for _, l := range sections[1] {
+		var r rule
+		if err := parse.Match(l, "%s %s %s -> %s", &r.in1, &r.op, &r.in2, &r.out); err != nil {
+			return nil, nil, err
+		}
+		if r.op != "AND" && r.op != "OR" && r.op != "XOR" {
+			return nil, nil, parse.Fields(l)[1].Errorf("unknown gate %q", r.op)
+		}
+		rules = append(rules, r)
+	}
+	return wires, rules, nil
+}
+
+// inputs returns the names of the x and y wires of the given width.
+func inputs(bits int) []string {
+	var ws []string
+	for _, name := range []byte("xy") {
+		for bit := range bits {
+			ws = append(ws, input(name, bit))
+		}
+	}
+	return ws
+}
+
+// countMistakes adds each power of two below 2^bits to zero, both ways, and
+// to itself, and counts the sums that come out wrong.
+func countMistakes(rules []rule, bits int) (int, error) {
+	m, _, err := mistakes(rules, bits)
+	return m, err
+}
+
+// mistakes counts the wrong sums as countMistakes does, and also returns the
+// outputs that were wrong in any of them. The sums are evaluated 64 at a
+// time.
+func mistakes(rules []rule, bits int) (int, map[string]bool, error) {
+	c, err := compile(inputs(bits), rules)
+	if err != nil {
+		return 0, nil, err
+	}
+	var tests [][2]int
+	for i := range bits {
+		tests = append(tests, [2]int{0, 1 << i}, [2]int{1 << i, 0}, [2]int{1 << i, 1 << i})
+	}
+	m := 0
+	wrong := map[string]bool{}
+	v := c.values()
+	for batch := range slices.Chunk(tests, 64) {
+		for lane, xy := range batch {
+			c.set(v, 'x', lane, xy[0])
+			c.set(v, 'y', lane, xy[1])
+		}
+		c.eval(v)
+		for lane, xy := range batch {
+			diff := c.get(v, 'z', lane) ^ (xy[0] + xy[1])
+			if diff != 0 {
+				m++
+			}
+			for j, d := 0, uint(diff); d != 0; j, d = j+1, d>>1 {
+				if d&1 != 0 {
+					wrong[input('z', j)] = true
+				}
+			}
+		}
+	}
+	return m, wrong, nil
+}
+
+func init() {
+	aoc.Register(2024, 24, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	wires map[string]int
+	rules []rule
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.wires, s.rules, err = extractInput(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	c, err := compile(slices.Sorted(maps.Keys(s.wires)), s.rules)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	v := c.values()
+	for w, val := range s.wires {
+		v[c.index[w]] = uint64(val)
+	}
+	c.eval(v)
+	return aoc.Int(c.get(v, 'z', 0)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	swapped, err := repair(s.wires, s.rules)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Text(swapped), nil
+}
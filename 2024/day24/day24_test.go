@@ -0,0 +1,14 @@
+package day24
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseUnknownGate(t *testing.T) {
+	s := &solver{}
+	err := s.Parse(strings.NewReader("x00: 1\ny00: 0\n\nx00 AND y00 -> z01\nx00 NAND y00 -> z00\n"))
+	if want := `5:5: unknown gate "NAND"`; err == nil || !strings.HasSuffix(err.Error(), want) {
+		t.Errorf("Parse() = %v, want %s", err, want)
+	}
+}
@@ -0,0 +1,109 @@
+package day24
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const debugHelp = `commands:
+  findings         explain where the circuit departs from an adder
+  bit n            explain the findings at bit n
+  dot [file]       export the circuit as a Graphviz graph, the wires that
+                   the wrong sums point at in red
+  verilog [file]   export the circuit as a Verilog module
+  help             print this help
+  quit             leave the debugger
+`
+
+// Debug explores the parsed circuit, reading commands from in until it ends
+// or a quit command.
+func (s *solver) Debug(in io.Reader, out io.Writer) error {
+	bits, err := width(s.wires)
+	if err != nil {
+		return err
+	}
+	findings, err := analyzeAdder(s.rules, bits)
+	if err != nil {
+		fmt.Fprintln(out, "error:", err)
+	}
+	fmt.Fprintf(out, "%d-bit adder of %d gates, %d findings\n", bits, len(s.rules), len(findings))
+	sc := bufio.NewScanner(in)
+	for {
+		fmt.Fprint(out, "(day24) ")
+		if !sc.Scan() {
+			fmt.Fprintln(out)
+			return sc.Err()
+		}
+		args := strings.Fields(sc.Text())
+		if len(args) == 0 {
+			continue
+		}
+		switch args[0] {
+		case "f", "findings":
+			for _, f := range findings {
+				fmt.Fprintln(out, f)
+			}
+		case "b", "bit":
+			bit := -1
+			if len(args) > 1 {
+				if n, err := strconv.Atoi(args[1]); err == nil {
+					bit = n
+				}
+			}
+			if bit < 0 || bit > bits {
+				fmt.Fprintf(out, "error: bit needs a number from 0 to %d\n", bits)
+				break
+			}
+			n := 0
+			for _, f := range findings {
+				if f.bit == bit {
+					fmt.Fprintln(out, f)
+					n++
+				}
+			}
+			if n == 0 {
+				fmt.Fprintf(out, "bit %d is wired as an adder\n", bit)
+			}
+		case "dot", "verilog":
+			if err := s.export(args, bits, out); err != nil {
+				fmt.Fprintln(out, "error:", err)
+			}
+		case "h", "help":
+			fmt.Fprint(out, debugHelp)
+		case "q", "quit":
+			return nil
+		default:
+			fmt.Fprintf(out, "error: unknown command %q; try help\n", args[0])
+		}
+	}
+}
+
+// export writes the circuit in the format of the command, to the file given
+// after it or to out.
+func (s *solver) export(args []string, bits int, out io.Writer) (err error) {
+	w := out
+	if len(args) > 1 {
+		f, err := os.Create(args[1])
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+		}()
+		w = f
+	}
+	if args[0] == "verilog" {
+		return writeVerilog(w, s.wires, s.rules)
+	}
+	suspect, err := suspects(s.rules, bits)
+	if err != nil {
+		return err
+	}
+	return writeDOT(w, s.wires, s.rules, suspect)
+}
@@ -0,0 +1,30 @@
+package day24
+
+import (
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+func TestDebug(t *testing.T) {
+	wires, rs, names := generateAdder(rand.New(rand.NewPCG(5, 6)), 6)
+	swapOutputs(rs, names["sum 2"], names["half 2"])
+	s := &solver{wires: wires, rules: rs}
+	var out strings.Builder
+	if err := s.Debug(strings.NewReader("findings\nbit 1\nbit 7\nquit\nfindings\n"), &out); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"6-bit adder of 27 gates, 1 findings\n",
+		"bit 2: the carry ",
+		"bit 1 is wired as an adder\n",
+		"error: bit needs a number from 0 to 6\n",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Debug() output lacks %q:\n%s", want, out.String())
+		}
+	}
+	if strings.Count(out.String(), "bit 2:") != 1 {
+		t.Errorf("Debug() went on after quit:\n%s", out.String())
+	}
+}
@@ -0,0 +1,132 @@
+package day24
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// gateColors fills the gates of each op in DOT graphs.
+var gateColors = map[string]string{
+	"AND": "lightblue",
+	"OR":  "palegreen",
+	"XOR": "gold",
+}
+
+// verilogOps are the Verilog operators for the gates' ops.
+var verilogOps = map[string]string{
+	"AND": "&",
+	"OR":  "|",
+	"XOR": "^",
+}
+
+// sortedRules returns the rules ordered by output, so that exports do not
+// depend on the order of the input.
+func sortedRules(rules []rule) []rule {
+	return slices.SortedFunc(slices.Values(rules), func(a, b rule) int {
+		return strings.Compare(a.out, b.out)
+	})
+}
+
+// suspects returns the wires that countMistakes points at: those feeding the
+// outputs that come out wrong, but none of those that come out right.
+func suspects(rules []rule, bits int) (map[string]bool, error) {
+	_, wrong, err := mistakes(rules, bits)
+	if err != nil {
+		return nil, err
+	}
+	producer := map[string]rule{}
+	for _, r := range rules {
+		producer[r.out] = r
+	}
+	// cone marks the wires that w depends on, w included.
+	var cone func(w string, seen map[string]bool)
+	cone = func(w string, seen map[string]bool) {
+		if seen[w] {
+			return
+		}
+		seen[w] = true
+		if r, ok := producer[w]; ok {
+			cone(r.in1, seen)
+			cone(r.in2, seen)
+		}
+	}
+	bad, good := map[string]bool{}, map[string]bool{}
+	for _, r := range rules {
+		if !strings.HasPrefix(r.out, "z") {
+			continue
+		}
+		if wrong[r.out] {
+			cone(r.out, bad)
+		} else {
+			cone(r.out, good)
+		}
+	}
+	for w := range bad {
+		if good[w] {
+			delete(bad, w)
+		}
+	}
+	return bad, nil
+}
+
+// writeDOT writes the circuit as a Graphviz graph, with a node per input wire
+// and per gate, named after the gate's output, and the suspected wires in red.
+func writeDOT(w io.Writer, wires map[string]int, rules []rule, suspect map[string]bool) error {
+	var b strings.Builder
+	b.WriteString("digraph circuit {\n\trankdir=LR;\n\tnode [style=filled];\n")
+	highlight := func(wire string) string {
+		if suspect[wire] {
+			return ", color=red, penwidth=3"
+		}
+		return ""
+	}
+	for _, in := range slices.Sorted(maps.Keys(wires)) {
+		fmt.Fprintf(&b, "\t%s [shape=circle, fillcolor=white%s];\n", in, highlight(in))
+	}
+	rs := sortedRules(rules)
+	for _, r := range rs {
+		shape := "box"
+		if strings.HasPrefix(r.out, "z") {
+			shape = "doubleoctagon"
+		}
+		fmt.Fprintf(&b, "\t%s [label=\"%s\\n%s\", shape=%s, fillcolor=%s%s];\n",
+			r.out, r.out, r.op, shape, gateColors[r.op], highlight(r.out))
+	}
+	for _, r := range rs {
+		fmt.Fprintf(&b, "\t%s -> %s;\n\t%s -> %s;\n", r.in1, r.out, r.in2, r.out)
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeVerilog writes the circuit as a structural Verilog module with the
+// initial wires as inputs and the z wires as outputs.
+func writeVerilog(w io.Writer, wires map[string]int, rules []rule) error {
+	rs := sortedRules(rules)
+	var ports, internal []string
+	for _, in := range slices.Sorted(maps.Keys(wires)) {
+		ports = append(ports, "input wire "+in)
+	}
+	for _, r := range rs {
+		if strings.HasPrefix(r.out, "z") {
+			ports = append(ports, "output wire "+r.out)
+		} else {
+			internal = append(internal, r.out)
+		}
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "module circuit (\n\t%s\n);\n", strings.Join(ports, ",\n\t"))
+	for _, wire := range internal {
+		fmt.Fprintf(&b, "\twire %s;\n", wire)
+	}
+	for _, r := range rs {
+		fmt.Fprintf(&b, "\tassign %s = %s %s %s;\n", r.out, r.in1, verilogOps[r.op], r.in2)
+	}
+	b.WriteString("endmodule\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,67 @@
+package day24
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+func TestExport(t *testing.T) {
+	for _, tc := range []struct {
+		input, golden string
+	}{
+		{"input-test.txt", "example"},
+		{"testdata/adder.txt", "adder"},
+	} {
+		f, err := os.Open(tc.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wires, rules, err := extractInput(f)
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		bits, err := width(wires)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var dot, v strings.Builder
+		suspect, err := suspects(rules, bits)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := writeDOT(&dot, wires, rules, suspect); err != nil {
+			t.Fatal(err)
+		}
+		if err := writeVerilog(&v, wires, rules); err != nil {
+			t.Fatal(err)
+		}
+		golden(t, tc.golden+".dot", dot.String())
+		golden(t, tc.golden+".v", v.String())
+	}
+}
+
+// golden compares got with the named file in testdata, or rewrites the file
+// with -update.
+func golden(t *testing.T, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Errorf("%s differs; got:\n%s", name, got)
+	}
+}
@@ -0,0 +1,33 @@
+module circuit (
+	input wire x00,
+	input wire x01,
+	input wire x02,
+	input wire y00,
+	input wire y01,
+	input wire y02,
+	output wire z00,
+	output wire z01,
+	output wire z02,
+	output wire z03
+);
+	wire cab;
+	wire cbc;
+	wire hab;
+	wire hbc;
+	wire oab;
+	wire obc;
+	wire sab;
+	wire sbc;
+	assign cab = x00 & y00;
+	assign cbc = oab | hab;
+	assign hab = x01 & y01;
+	assign hbc = x02 & y02;
+	assign oab = sab & cab;
+	assign obc = sbc ^ cbc;
+	assign sab = x01 ^ y01;
+	assign sbc = x02 ^ y02;
+	assign z00 = x00 ^ y00;
+	assign z01 = sab ^ cab;
+	assign z02 = sbc & cbc;
+	assign z03 = obc | hbc;
+endmodule
@@ -0,0 +1,85 @@
+module circuit (
+	input wire x00,
+	input wire x01,
+	input wire x02,
+	input wire x03,
+	input wire x04,
+	input wire y00,
+	input wire y01,
+	input wire y02,
+	input wire y03,
+	input wire y04,
+	output wire z00,
+	output wire z01,
+	output wire z02,
+	output wire z03,
+	output wire z04,
+	output wire z05,
+	output wire z06,
+	output wire z07,
+	output wire z08,
+	output wire z09,
+	output wire z10,
+	output wire z11,
+	output wire z12
+);
+	wire bfw;
+	wire bqk;
+	wire djm;
+	wire ffh;
+	wire fgs;
+	wire frj;
+	wire fst;
+	wire gnj;
+	wire hwm;
+	wire kjc;
+	wire kpj;
+	wire kwq;
+	wire mjb;
+	wire nrd;
+	wire ntg;
+	wire pbm;
+	wire psh;
+	wire qhw;
+	wire rvg;
+	wire tgd;
+	wire tnw;
+	wire vdt;
+	wire wpb;
+	assign bfw = vdt | tnw;
+	assign bqk = ffh | nrd;
+	assign djm = y00 & y03;
+	assign ffh = x03 ^ y03;
+	assign fgs = y04 | y02;
+	assign frj = tnw | fst;
+	assign fst = x00 | x03;
+	assign gnj = tnw | pbm;
+	assign hwm = nrd & vdt;
+	assign kjc = x04 & y00;
+	assign kpj = pbm | djm;
+	assign kwq = ntg | kjc;
+	assign mjb = ntg ^ fgs;
+	assign nrd = y03 | x01;
+	assign ntg = x00 ^ y04;
+	assign pbm = y01 & x02;
+	assign psh = y03 | y00;
+	assign qhw = djm | pbm;
+	assign rvg = kjc & fst;
+	assign tgd = psh ^ fgs;
+	assign tnw = y02 | x01;
+	assign vdt = x03 | x00;
+	assign wpb = nrd ^ fgs;
+	assign z00 = bfw ^ mjb;
+	assign z01 = tgd ^ rvg;
+	assign z02 = gnj & wpb;
+	assign z03 = hwm & bqk;
+	assign z04 = frj ^ qhw;
+	assign z05 = kwq | kpj;
+	assign z06 = bfw | bqk;
+	assign z07 = bqk | frj;
+	assign z08 = bqk | frj;
+	assign z09 = qhw ^ tgd;
+	assign z10 = bfw & frj;
+	assign z11 = gnj & tgd;
+	assign z12 = tgd ^ rvg;
+endmodule
@@ -0,0 +1,100 @@
+package day25
+
+import (
+	"io"
+	"strings"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
+)
+
+func extractSchematics(r io.Reader) ([][5]int, [][5]int, error) {
+	sections, err := parse.Sections(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	locks := [][5]int{}
+	keys := [][5]int{}
+	for _, s := range sections {
+		rows, err := parse.Grid(s)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(rows[0]) != 5 {
+			return nil, nil, s[0].Errorf("schematic is %d wide, want 5", len(rows[0]))
+		}
+		if strings.HasPrefix(s[0].Text, "#") {
+			locks = append(locks, parseLock(rows))
+		} else {
+			keys = append(keys, parseKey(rows))
+		}
+	}
+	return locks, keys, nil
+}
+
+func parseLock(rows [][]rune) [5]int {
+	l := [5]int{}
+	for i := 1; i < len(rows); i++ {
+		for j, v := range rows[i] {
+			if v == '#' {
+				l[j]++
+			}
+		}
+	}
+	return l
+}
+
+func parseKey(rows [][]rune) [5]int {
+	l := [5]int{}
+	for i := len(rows) - 2; i > 0; i-- {
+		for j, v := range rows[i] {
+			if v == '#' {
+				l[j]++
+			}
+		}
+	}
+	return l
+}
+
+func hasOverlap(lock, key [5]int) bool {
+	for i := range lock {
+		if lock[i]+key[i] > 5 {
+			return true
+		}
+	}
+	return false
+}
+
+func part1(locks, keys [][5]int) int {
+	c := 0
+	for _, l := range locks {
+		for _, k := range keys {
+			if !hasOverlap(l, k) {
+				c++
+			}
+		}
+	}
+	return c
+}
+
+func init() {
+	aoc.Register(2024, 25, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	locks, keys [][5]int
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.locks, s.keys, err = extractSchematics(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(part1(s.locks, s.keys)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	// Day 25 only has the one puzzle.
+	return aoc.Answer{}, nil
+}
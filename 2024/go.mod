@@ -1,3 +0,0 @@
-module github.com/liviro/aoc/2024
-
-go 1.23
@@ -0,0 +1,99 @@
+package day01
+
+import (
+	"io"
+	"strings"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
+)
+
+type rotation struct {
+	distance int
+	dir      string
+}
+
+func extractRotations(r io.Reader) ([]rotation, error) {
+	ls, err := parse.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+	var rs []rotation
+	for _, l := range ls {
+		if !strings.HasPrefix(l.Text, "L") && !strings.HasPrefix(l.Text, "R") {
+			return nil, l.Errorf("expected rotation direction L or R")
+		}
+		d, err := l.Slice(1, len(l.Text)).Int()
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, rotation{
+			distance: d,
+			dir:      l.Text[:1],
+		})
+	}
+	return rs, nil
+}
+
+func rotate(start int, r rotation) (position, zeros int) {
+	position = start
+	if r.dir == "L" {
+		position -= r.distance
+	} else {
+		position += r.distance
+	}
+	if position < 0 {
+		zeros = -1 * (position + 1) / 100
+		if start != 0 {
+			zeros++
+		}
+	} else {
+		zeros = (position - 1) / 100
+	}
+	position %= 100
+	if position < 0 {
+		position += 100
+	}
+	if position == 0 {
+		zeros++
+	}
+	return position, zeros
+}
+
+func passwords(rs []rotation) (int, int) {
+	p := 50
+	zeroPos := 0
+	zeroClick := 0
+	for _, r := range rs {
+		pos, z := rotate(p, r)
+		p = pos
+		zeroClick += z
+		if p == 0 {
+			zeroPos++
+		}
+	}
+	return zeroPos, zeroClick
+}
+
+func init() {
+	aoc.Register(2025, 1, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	rs []rotation
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.rs, err = extractRotations(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	p1, _ := passwords(s.rs)
+	return aoc.Int(p1), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	_, p2 := passwords(s.rs)
+	return aoc.Int(p2), nil
+}
@@ -0,0 +1,99 @@
+package day02
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
+)
+
+type interval struct {
+	start, end int
+}
+
+func extractRanges(r io.Reader) ([]interval, error) {
+	ls, err := parse.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+	res := []interval{}
+	for _, l := range ls {
+		for _, f := range parse.Split(l, ",") {
+			it := interval{}
+			if err := parse.Match(f, "%d-%d", &it.start, &it.end); err != nil {
+				return nil, err
+			}
+			res = append(res, it)
+		}
+	}
+	return res, nil
+}
+
+func isInvalidPt1(n int) bool {
+	s := fmt.Sprint(n)
+	if len(s)%2 != 0 {
+		return false
+	}
+	return s[:len(s)/2] == s[len(s)/2:]
+}
+
+func isInvalidPt2(n int) bool {
+	s := fmt.Sprint(n)
+L:
+	for l := 2; l <= len(s); l++ {
+		if len(s)%l != 0 {
+			continue
+		}
+		x := s[:len(s)/l]
+		rest := s[len(s)/l:]
+		for {
+			if len(rest) == 0 {
+				return true
+			}
+			if x != rest[:len(s)/l] {
+				continue L
+			}
+			rest = rest[len(s)/l:]
+		}
+	}
+	return false
+}
+
+func invalidSums(its []interval) (int, int) {
+	p1, p2 := 0, 0
+	for _, it := range its {
+		for a := it.start; a <= it.end; a++ {
+			if isInvalidPt1(a) {
+				p1 += a
+			}
+			if isInvalidPt2(a) {
+				p2 += a
+			}
+		}
+	}
+	return p1, p2
+}
+
+func init() {
+	aoc.Register(2025, 2, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	its []interval
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.its, err = extractRanges(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	p1, _ := invalidSums(s.its)
+	return aoc.Int(p1), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	_, p2 := invalidSums(s.its)
+	return aoc.Int(p2), nil
+}
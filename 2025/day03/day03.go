@@ -0,0 +1,74 @@
+package day03
+
+import (
+	"io"
+	"math"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
+)
+
+func extractGrid(r io.Reader) ([][]int, error) {
+	ls, err := parse.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+	grid := [][]int{}
+	for _, l := range ls {
+		bank, err := parse.Digits(l)
+		if err != nil {
+			return nil, err
+		}
+		grid = append(grid, bank)
+	}
+	return grid, nil
+}
+
+func maxJoltage(row []int, digits int) int {
+	res := 0
+	idx := -1
+	for d := digits - 1; d >= 0; d-- {
+		x := 0
+		for i := idx + 1; i < len(row)-d; i++ {
+			if row[i] > x {
+				x = row[i]
+				idx = i
+			}
+		}
+		res += x * int(math.Pow10(d))
+	}
+	return res
+}
+
+func joltages(grid [][]int) (int, int) {
+	s1 := 0
+	s2 := 0
+	for _, b := range grid {
+		s1 += maxJoltage(b, 2)
+		s2 += maxJoltage(b, 12)
+	}
+	return s1, s2
+}
+
+func init() {
+	aoc.Register(2025, 3, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	grid [][]int
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.grid, err = extractGrid(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	p1, _ := joltages(s.grid)
+	return aoc.Int(p1), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	_, p2 := joltages(s.grid)
+	return aoc.Int(p2), nil
+}
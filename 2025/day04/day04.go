@@ -0,0 +1,104 @@
+package day04
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/liviro/aoc/internal/anim"
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/grid"
+)
+
+type rolls = *grid.Sparse[bool]
+
+func countNeighborRolls(g rolls, c grid.Point) int {
+	r := 0
+	for _, n := range c.Neighbors8() {
+		if g.Has(n) {
+			r++
+		}
+	}
+	return r
+}
+
+func clear(g rolls) (rolls, int) {
+	ng := grid.NewSparse[bool]()
+	c := 0
+	for r := range g.All() {
+		if countNeighborRolls(g, r) >= 4 {
+			ng.Set(r, true)
+		} else {
+			c++
+		}
+	}
+	return ng, c
+}
+
+// render draws the rolls left in g as @, and those cleared since start as x,
+// within the bounds of start.
+func render(start, g rolls) string {
+	return start.Render(func(p grid.Point, _ bool, ok bool) rune {
+		switch {
+		case g.Has(p):
+			return '@'
+		case ok:
+			return 'x'
+		}
+		return '.'
+	})
+}
+
+func part1(g rolls, frames anim.Sink) int {
+	ng, c := clear(g)
+	anim.Emit(frames, fmt.Sprintf("part 1, %d rolls cleared", c), func() string { return render(g, ng) })
+	return c
+}
+
+func part2(start rolls, frames anim.Sink) int {
+	g := start
+	s := 0
+	for round := 1; ; round++ {
+		ng, c := clear(g)
+		s += c
+		g = ng
+		anim.Emit(frames, fmt.Sprintf("part 2, round %d, %d rolls cleared", round, c), func() string { return render(start, g) })
+		if c == 0 {
+			break
+		}
+	}
+	return s
+}
+
+func extractRolls(r io.Reader) (rolls, error) {
+	g, err := grid.Read(r, grid.OneOf(map[rune]bool{'.': false, '@': true}))
+	if err != nil {
+		return nil, err
+	}
+	return g.Sparse(func(roll bool) bool { return roll }), nil
+}
+
+func init() {
+	aoc.Register(2025, 4, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	rolls  rolls
+	frames anim.Sink
+}
+
+func (s *solver) Animate(frames anim.Sink) {
+	s.frames = frames
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.rolls, err = extractRolls(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(part1(s.rolls, s.frames)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(part2(s.rolls, s.frames)), nil
+}
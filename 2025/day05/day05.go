@@ -1,13 +1,12 @@
-package main
+package day05
 
 import (
 	"fmt"
-	"os"
+	"io"
 	"slices"
-	"strings"
-	"time"
 
-	"github.com/liviro/aoc/2025/internal/parse"
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
 )
 
 type fresh struct{ min, max int }
@@ -16,33 +15,47 @@ func (f fresh) String() string {
 	return fmt.Sprintf("[%d, %d]", f.min, f.max)
 }
 
-func parseFresh(raw string) []fresh {
+func parseFresh(lines []parse.Span) ([]fresh, error) {
 	var fs []fresh
-	for _, r := range strings.Split(raw, "\n") {
-		ps := strings.Split(r, "-")
-		fs = append(fs, fresh{
-			min: parse.MustInt(ps[0]),
-			max: parse.MustInt(ps[1]),
-		})
+	for _, l := range lines {
+		f := fresh{}
+		if err := parse.Match(l, "%d-%d", &f.min, &f.max); err != nil {
+			return nil, err
+		}
+		fs = append(fs, f)
 	}
-	return fs
+	return fs, nil
 }
 
-func parseIngredients(raw string) []int {
+func parseIngredients(lines []parse.Span) ([]int, error) {
 	var is []int
-	for r := range strings.SplitSeq(raw, "\n") {
-		is = append(is, parse.MustInt(r))
+	for _, l := range lines {
+		i, err := l.Int()
+		if err != nil {
+			return nil, err
+		}
+		is = append(is, i)
 	}
-	return is
+	return is, nil
 }
 
-func extractDatabase(name string) ([]fresh, []int) {
-	raw, err := os.ReadFile(name)
+func extractDatabase(r io.Reader) ([]fresh, []int, error) {
+	sections, err := parse.Sections(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(sections) != 2 {
+		return nil, nil, fmt.Errorf("got %d sections, want fresh ranges and ingredients", len(sections))
+	}
+	fs, err := parseFresh(sections[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	is, err := parseIngredients(sections[1])
 	if err != nil {
-		panic("Unable to open file")
+		return nil, nil, err
 	}
-	sections := strings.Split(strings.TrimSpace(string(raw)), "\n\n")
-	return parseFresh(sections[0]), parseIngredients(sections[1])
+	return fs, is, nil
 }
 
 func isFresh(freshRanges []fresh, i int) bool {
@@ -117,10 +130,24 @@ func part2(freshRanges []fresh) int {
 	return c
 }
 
-func main() {
-	t := time.Now()
-	fs, is := extractDatabase(os.Args[1])
-	fmt.Printf("Part 1: %d\n", part1(fs, is))
-	fmt.Printf("Part 2: %d\n", part2(fs))
-	fmt.Printf("Time elapsed: %s\n", time.Since(t))
+func init() {
+	aoc.Register(2025, 5, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	fs []fresh
+	is []int
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.fs, s.is, err = extractDatabase(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(part1(s.fs, s.is)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(part2(s.fs)), nil
 }
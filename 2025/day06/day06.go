@@ -0,0 +1,153 @@
+package day06
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
+)
+
+type problem struct {
+	operator string
+	numbers  []int
+}
+
+func (p problem) solve() int {
+	var r int
+	if p.operator == "*" {
+		r = 1
+		for _, v := range p.numbers {
+			r *= v
+		}
+	}
+	if p.operator == "+" {
+		for _, v := range p.numbers {
+			r += v
+		}
+	}
+	return r
+}
+
+// The worksheet is read differently in each part, so it is kept as raw lines.
+func extractLines(r io.Reader) ([]parse.Span, error) {
+	ls, err := parse.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+	// Columns are significant in the second part, so rows must line up.
+	if _, err := parse.Grid(ls); err != nil {
+		return nil, err
+	}
+	return ls, nil
+}
+
+func extractProblemsPt1(lines []parse.Span) ([]problem, error) {
+	var ps []problem
+	for _, l := range lines {
+		raw := parse.Fields(l)
+		if len(ps) == 0 {
+			ps = make([]problem, len(raw))
+		}
+		if len(raw) != len(ps) {
+			return nil, l.Errorf("row has %d entries, want %d", len(raw), len(ps))
+		}
+		for i, v := range raw {
+			if v.Text == "+" {
+				ps[i].operator = "+"
+				continue
+			}
+			if v.Text == "*" {
+				ps[i].operator = "*"
+				continue
+			}
+
+			n, err := v.Int()
+			if err != nil {
+				return nil, err
+			}
+			ps[i].numbers = append(ps[i].numbers, n)
+		}
+	}
+	return ps, nil
+}
+
+func extractProblemsPt2(lines []parse.Span) ([]problem, error) {
+	rawLines := make([]string, len(lines))
+	for i, l := range lines {
+		rawLines[i] = l.Text
+	}
+
+	var ps []problem
+	p := problem{}
+	for i := len(rawLines[0]) - 1; i >= 0; i-- {
+		// If all-blank column, move onto next problem
+		allBlank := true
+		for y := 0; y < len(rawLines); y++ {
+			if rawLines[y][i] != ' ' {
+				allBlank = false
+			}
+		}
+		if allBlank {
+			ps = append(ps, p)
+			p = problem{}
+			continue
+		}
+
+		var rn strings.Builder
+		for j := 0; j < len(rawLines)-1; j++ {
+			if rawLines[j][i] != ' ' {
+				rn.WriteByte(rawLines[j][i])
+			}
+		}
+		n, err := strconv.Atoi(rn.String())
+		if err != nil {
+			return nil, lines[0].Slice(i, i+1).Errorf("column does not hold a number: %q", rn.String())
+		}
+		p.numbers = append(p.numbers, n)
+
+		if rawLines[len(rawLines)-1][i] != ' ' {
+			p.operator = string(rawLines[len(rawLines)-1][i])
+		}
+	}
+	ps = append(ps, p)
+	return ps, nil
+}
+
+func grandTotal(ps []problem) int {
+	s := 0
+	for _, p := range ps {
+		s += p.solve()
+	}
+	return s
+}
+
+func init() {
+	aoc.Register(2025, 6, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	lines []parse.Span
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.lines, err = extractLines(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	ps, err := extractProblemsPt1(s.lines)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Int(grandTotal(ps)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	ps, err := extractProblemsPt2(s.lines)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Int(grandTotal(ps)), nil
+}
@@ -0,0 +1,72 @@
+package day07
+
+import (
+	"errors"
+	"io"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/grid"
+)
+
+type diagram struct {
+	start grid.Point
+	m     *grid.Grid[rune]
+}
+
+func extractDiagram(r io.Reader) (diagram, error) {
+	m, err := grid.Read(r, grid.RunesOf(".S^"))
+	if err != nil {
+		return diagram{}, err
+	}
+	start, ok := m.Find(func(v rune) bool { return v == 'S' })
+	if !ok {
+		return diagram{}, errors.New("no start in diagram")
+	}
+	return diagram{start: start, m: m}, nil
+}
+
+func (d diagram) analyze() (splits, timelines int) {
+	beams := make(map[int]int)
+	beams[d.start.X] = 1
+	for j := 2; j < d.m.H-1; j += 2 {
+		nb := beams
+		for i := 0; i < d.m.W; i++ {
+			hasSplitter := d.m.At(grid.Point{X: i, Y: j}) == '^'
+			_, hasBeam := beams[i]
+			if hasSplitter && hasBeam {
+				splits++
+				nb[i-1] += beams[i]
+				nb[i+1] += beams[i]
+				delete(nb, i)
+			}
+		}
+		beams = nb
+	}
+	for _, t := range beams {
+		timelines += t
+	}
+	return splits, timelines
+}
+
+func init() {
+	aoc.Register(2025, 7, func() aoc.Solver { return &solver{} })
+}
+
+type solver struct {
+	d diagram
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.d, err = extractDiagram(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	splits, _ := s.d.analyze()
+	return aoc.Int(splits), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	_, timelines := s.d.analyze()
+	return aoc.Int(timelines), nil
+}
@@ -1,16 +1,14 @@
-package main
+package day08
 
 import (
-	"bufio"
 	"fmt"
+	"io"
 	"math"
-	"os"
 	"slices"
 	"sort"
-	"strings"
-	"time"
 
-	"github.com/liviro/aoc/2025/internal/parse"
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/parse"
 )
 
 type position struct {
@@ -36,23 +34,20 @@ func (c conn) String() string {
 	return fmt.Sprintf("%s - %s (dist = %.2f)", c.a, c.b, c.dist)
 }
 
-func extractBoxes(name string) []position {
-	fp, err := os.Open(name)
+func extractBoxes(r io.Reader) ([]position, error) {
+	ls, err := parse.Lines(r)
 	if err != nil {
-		panic("Unable to open file")
+		return nil, err
 	}
-	defer fp.Close()
-	s := bufio.NewScanner(fp)
 	var ps []position
-	for s.Scan() {
-		raw := strings.Split(s.Text(), ",")
-		ps = append(ps, position{
-			x: parse.MustInt(raw[0]),
-			y: parse.MustInt(raw[1]),
-			z: parse.MustInt(raw[2]),
-		})
+	for _, l := range ls {
+		p := position{}
+		if err := parse.Match(l, "%d,%d,%d", &p.x, &p.y, &p.z); err != nil {
+			return nil, err
+		}
+		ps = append(ps, p)
 	}
-	return ps
+	return ps, nil
 }
 
 func allDistances(bs []position) []conn {
@@ -102,10 +97,10 @@ func addToCircuit(circuits []map[position]struct{}, c conn) []map[position]struc
 	return circuits
 }
 
-func part1(bs []position) int {
+func part1(bs []position, pairs int) int {
 	all := allDistances(bs)
 	sort.Slice(all, func(i, j int) bool { return all[i].dist < all[j].dist })
-	top := all[:1000]
+	top := all[:min(pairs, len(all))]
 	var cs []map[position]struct{}
 	for _, c := range top {
 		cs = addToCircuit(cs, c)
@@ -137,10 +132,30 @@ func part2(bs []position) int {
 	return 0
 }
 
-func main() {
-	t := time.Now()
-	bs := extractBoxes(os.Args[1])
-	fmt.Printf("Part 1: %d\n", part1(bs))
-	fmt.Printf("Part 2: %d\n", part2(bs))
-	fmt.Printf("Time elapsed: %s\n", time.Since(t))
+func init() {
+	aoc.Register(2025, 8, func() aoc.Solver { return &solver{pairs: 1000} })
+}
+
+type solver struct {
+	pairs int
+	bs    []position
+}
+
+func (s *solver) Params() []aoc.Param {
+	return []aoc.Param{
+		{Name: "pairs", Usage: "closest pairs of boxes connected in part 1", Value: &s.pairs},
+	}
+}
+
+func (s *solver) Parse(r io.Reader) (err error) {
+	s.bs, err = extractBoxes(r)
+	return err
+}
+
+func (s *solver) Part1() (aoc.Answer, error) {
+	return aoc.Int(part1(s.bs, s.pairs)), nil
+}
+
+func (s *solver) Part2() (aoc.Answer, error) {
+	return aoc.Int(part2(s.bs)), nil
 }
@@ -1,3 +0,0 @@
-module github.com/liviro/aoc/2025
-
-go 1.25
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/bench"
+)
+
+// benchCmd measures the parse and both parts of a single day, or of every
+// registered day of a year, separately. The results can be saved, and
+// compared against a previous run to flag regressions.
+func benchCmd(args []string) error {
+	fs := flag.NewFlagSet("bench", flag.ContinueOnError)
+	year := fs.Int("year", 0, "puzzle year")
+	day := fs.Int("day", 0, "puzzle day; all registered days of the year if unset")
+	n := fs.Int("n", 3, "iterations of each day")
+	input := fs.String("input", "", "input file; <year>/dayNN/input.txt under -root, or the cached input, if unset")
+	root := fs.String("root", ".", "repository root, used to locate default inputs")
+	cfgPath := fs.String("config", defaultConfigPath(), "configuration file, locating the input cache")
+	params := paramsFlag{}
+	fs.Var(params, "param", "solver parameter override as name=value; may be repeated")
+	out := fs.String("json", "", "file to save the results to, as JSON")
+	prev := fs.String("compare", "", "results of a previous run, saved with -json, to flag regressions against")
+	threshold := fs.Float64("threshold", 0.2, "fraction by which a phase may get slower, or allocate more, before it is flagged")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *year == 0 {
+		return errors.New("bench: -year is required")
+	}
+	cfg, err := loadConfig(*cfgPath)
+	if err != nil {
+		return err
+	}
+
+	var ps []aoc.Puzzle
+	if *day == 0 {
+		if *input != "" || len(params) > 0 {
+			return errors.New("bench: -input and -param require -day")
+		}
+		ps = aoc.Days(*year)
+		if len(ps) == 0 {
+			return fmt.Errorf("bench: no solvers registered for %d", *year)
+		}
+	} else {
+		p, ok := aoc.Lookup(*year, *day)
+		if !ok {
+			return fmt.Errorf("bench: no solver registered for %d day %d", *year, *day)
+		}
+		ps = []aoc.Puzzle{p}
+	}
+
+	var old []bench.Result
+	if *prev != "" {
+		if old, err = bench.Load(*prev); err != nil {
+			return err
+		}
+	}
+
+	var rs []bench.Result
+	for _, p := range ps {
+		in := *input
+		if in == "" {
+			in = cfg.defaultInput(p, *root)
+		}
+		raw, err := os.ReadFile(in)
+		if err != nil {
+			return err
+		}
+		r, err := bench.Measure(p, raw, params, *n)
+		if err != nil {
+			return err
+		}
+		rs = append(rs, r)
+	}
+
+	printResults(rs, old)
+	if *out != "" {
+		if err := bench.Save(*out, rs); err != nil {
+			return err
+		}
+	}
+	if regs := bench.Compare(old, rs, *threshold); len(regs) > 0 {
+		for _, r := range regs {
+			fmt.Fprintln(os.Stderr, "aoc: regression:", r)
+		}
+		return fmt.Errorf("bench: %d regressions", len(regs))
+	}
+	return nil
+}
+
+// printResults prints a table of the results, along with the change in time
+// since the previous results if there are any.
+func printResults(rs, old []bench.Result) {
+	prev := map[string]bench.Phase{}
+	for _, r := range old {
+		for _, ph := range r.Phases {
+			prev[fmt.Sprintf("%d/%d/%s", r.Year, r.Day, ph.Name)] = ph
+		}
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	header := "year\tday\tphase\tn\ttime/op\tallocs/op\tbytes/op\t"
+	if len(old) > 0 {
+		header += "prev time/op\tdelta\t"
+	}
+	fmt.Fprintln(w, header)
+	for _, r := range rs {
+		for _, ph := range r.Phases {
+			fmt.Fprintf(w, "%d\t%d\t%s\t%d\t%s\t%d\t%d\t", r.Year, r.Day, ph.Name, ph.N,
+				time.Duration(ph.NsPerOp), ph.AllocsPerOp, ph.BytesPerOp)
+			if len(old) > 0 {
+				if o, ok := prev[fmt.Sprintf("%d/%d/%s", r.Year, r.Day, ph.Name)]; ok && o.NsPerOp > 0 {
+					delta := 100 * float64(ph.NsPerOp-o.NsPerOp) / float64(o.NsPerOp)
+					fmt.Fprintf(w, "%s\t%s%%\t", time.Duration(o.NsPerOp), strconv.FormatFloat(delta, 'f', 1, 64))
+				} else {
+					fmt.Fprint(w, "-\t-\t")
+				}
+			}
+			fmt.Fprintln(w)
+		}
+	}
+	w.Flush()
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/site"
+)
+
+// config holds the user's settings for talking to the site, read from
+// config.json in the user's configuration directory (e.g. ~/.config/aoc).
+type config struct {
+	// Session is the value of the session cookie of a logged-in browser.
+	// The AOC_SESSION environment variable takes precedence over it.
+	Session string `json:"session"`
+	// BaseURL is the address of the site, to point at a stand-in.
+	BaseURL string `json:"base_url"`
+	// UserAgent should name the user, so that the site's maintainers can
+	// reach them if the tool misbehaves.
+	UserAgent string `json:"user_agent"`
+	// InputsDir is where inputs are cached, relative to the repository root
+	// unless absolute.
+	InputsDir string `json:"inputs_dir"`
+	// HistoryFile is where submissions are recorded, relative to the
+	// repository root unless absolute.
+	HistoryFile string `json:"history_file"`
+}
+
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "aoc", "config.json")
+}
+
+// loadConfig reads the configuration at path, falling back to defaults for
+// anything it leaves unset. A missing file is not an error.
+func loadConfig(path string) (config, error) {
+	var c config
+	if path != "" {
+		raw, err := os.ReadFile(path)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+		case err != nil:
+			return c, err
+		default:
+			if err := json.Unmarshal(raw, &c); err != nil {
+				return c, fmt.Errorf("%s: %w", path, err)
+			}
+		}
+	}
+	if s := os.Getenv("AOC_SESSION"); s != "" {
+		c.Session = s
+	}
+	if c.BaseURL == "" {
+		c.BaseURL = site.DefaultBaseURL
+	}
+	if c.InputsDir == "" {
+		c.InputsDir = site.DefaultInputsDir
+	}
+	if c.HistoryFile == "" {
+		c.HistoryFile = site.DefaultHistoryPath
+	}
+	return c, nil
+}
+
+// client returns a client for the configured site.
+func (c config) client() *site.Client {
+	cl := site.NewClient(c.BaseURL, c.Session)
+	if c.UserAgent != "" {
+		cl.UserAgent = c.UserAgent
+	}
+	return cl
+}
+
+// inputs returns the input cache of the repository at root.
+func (c config) inputs(root string) site.Inputs {
+	return site.Inputs{Dir: underRoot(root, c.InputsDir), Client: c.client()}
+}
+
+// historyPath returns the submission history of the repository at root.
+func (c config) historyPath(root string) string {
+	return underRoot(root, c.HistoryFile)
+}
+
+func underRoot(root, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(root, path)
+}
+
+// defaultInput returns the input of the puzzle: the one next to its code
+// under root if there is one, else the cached one.
+func (c config) defaultInput(p aoc.Puzzle, root string) string {
+	in := filepath.Join(root, p.InputPath())
+	if _, err := os.Stat(in); err == nil {
+		return in
+	}
+	return c.inputs(root).Path(p.Year, p.Day)
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/liviro/aoc/internal/aoc"
+)
+
+// debugCmd parses a day's input and hands it to the day's interactive
+// debugger, driven from stdin.
+func debugCmd(args []string) error {
+	fs := flag.NewFlagSet("debug", flag.ContinueOnError)
+	year := fs.Int("year", 0, "puzzle year")
+	day := fs.Int("day", 0, "puzzle day")
+	input := fs.String("input", "", "input file; <year>/dayNN/input.txt under -root, or the cached input, if unset")
+	root := fs.String("root", ".", "repository root, used to locate default inputs")
+	cfgPath := fs.String("config", defaultConfigPath(), "configuration file, locating the input cache")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *year == 0 || *day == 0 {
+		return errors.New("debug: -year and -day are required")
+	}
+	cfg, err := loadConfig(*cfgPath)
+	if err != nil {
+		return err
+	}
+	p, ok := aoc.Lookup(*year, *day)
+	if !ok {
+		return fmt.Errorf("debug: no solver registered for %d day %d", *year, *day)
+	}
+	s, ok := p.New().(aoc.Debugger)
+	if !ok {
+		return fmt.Errorf("debug: %d day %d has no debugger", *year, *day)
+	}
+	in := *input
+	if in == "" {
+		in = cfg.defaultInput(p, *root)
+	}
+	f, err := os.Open(in)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := s.Parse(f); err != nil {
+		return fmt.Errorf("%d day %d: parse: %w", p.Year, p.Day, err)
+	}
+	return s.Debug(os.Stdin, os.Stdout)
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/liviro/aoc/internal/aoc"
+)
+
+// fetchCmd downloads the input of a single day, or of every registered day of
+// a year, into the input cache. Inputs already cached are left alone.
+func fetchCmd(args []string) error {
+	fs := flag.NewFlagSet("fetch", flag.ContinueOnError)
+	year := fs.Int("year", 0, "puzzle year")
+	day := fs.Int("day", 0, "puzzle day; all registered days of the year if unset")
+	root := fs.String("root", ".", "repository root, holding the input cache")
+	cfgPath := fs.String("config", defaultConfigPath(), "configuration file")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *year == 0 {
+		return errors.New("fetch: -year is required")
+	}
+	cfg, err := loadConfig(*cfgPath)
+	if err != nil {
+		return err
+	}
+
+	days := []int{*day}
+	if *day == 0 {
+		days = nil
+		for _, p := range aoc.Days(*year) {
+			days = append(days, p.Day)
+		}
+		if len(days) == 0 {
+			return fmt.Errorf("fetch: no solvers registered for %d", *year)
+		}
+	}
+	in := cfg.inputs(*root)
+	for _, d := range days {
+		path, fetched, err := in.Fetch(*year, d)
+		if err != nil {
+			return fmt.Errorf("fetch: %w", err)
+		}
+		if fetched {
+			fmt.Printf("%d day %d: fetched into %s\n", *year, d, path)
+		} else {
+			fmt.Printf("%d day %d: already cached in %s\n", *year, d, path)
+		}
+	}
+	return nil
+}
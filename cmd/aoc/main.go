@@ -0,0 +1,62 @@
+// Command aoc runs the Advent of Code solvers of any year and day.
+//
+// Usage:
+//
+//	aoc run -year 2024 [-day 16] [-input path] [-param name=value ...]
+//	aoc run -year 2024 -day 14 -animate [-fps 10] [-frames frames.txt]
+//	aoc run -year 2024 -day 15 -render out.gif [-cell 4] [-palette '#=808080'] [-fps 10] [-every 1] [-max-frames 0]
+//	aoc fetch -year 2024 [-day 16]
+//	aoc submit -year 2024 -day 16 -part 1 [-answer 72428]
+//	aoc debug -year 2024 -day 17
+//	aoc play [-input path] 2024 15
+//	aoc bench -year 2024 [-day 16] [-n 3] [-json out.json] [-compare prev.json]
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	_ "github.com/liviro/aoc/internal/days"
+)
+
+// command is a subcommand of aoc, receiving the arguments that follow its name.
+type command func(args []string) error
+
+var commands = map[string]command{
+	"bench":  benchCmd,
+	"debug":  debugCmd,
+	"fetch":  fetchCmd,
+	"play":   playCmd,
+	"run":    runCmd,
+	"submit": submitCmd,
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: aoc <command> [flags]")
+	fmt.Fprintln(os.Stderr, "commands:")
+	var names []string
+	for n := range commands {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		fmt.Fprintln(os.Stderr, "\t"+n)
+	}
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		usage()
+		os.Exit(2)
+	}
+	if err := cmd(os.Args[2:]); err != nil {
+		fmt.Fprintln(os.Stderr, "aoc:", err)
+		os.Exit(1)
+	}
+}
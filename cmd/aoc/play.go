@@ -0,0 +1,74 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/liviro/aoc/internal/anim"
+	"github.com/liviro/aoc/internal/aoc"
+)
+
+// playCmd parses a day's input and lets its puzzle be played by hand, with
+// keys read from stdin, in raw mode if it is a terminal. The year and day may
+// be given as flags, or as the two arguments after them.
+func playCmd(args []string) error {
+	fs := flag.NewFlagSet("play", flag.ContinueOnError)
+	year := fs.Int("year", 0, "puzzle year")
+	day := fs.Int("day", 0, "puzzle day")
+	input := fs.String("input", "", "input file; <year>/dayNN/input.txt under -root, or the cached input, if unset")
+	root := fs.String("root", ".", "repository root, used to locate default inputs")
+	cfgPath := fs.String("config", defaultConfigPath(), "configuration file, locating the input cache")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 2 {
+		y, yerr := strconv.Atoi(fs.Arg(0))
+		d, derr := strconv.Atoi(fs.Arg(1))
+		if yerr != nil || derr != nil {
+			return fmt.Errorf("play: want a year and a day, got %q %q", fs.Arg(0), fs.Arg(1))
+		}
+		*year, *day = y, d
+	} else if fs.NArg() != 0 {
+		return errors.New("play: want a year and a day, as flags or arguments")
+	}
+	if *year == 0 || *day == 0 {
+		return errors.New("play: a year and a day are required")
+	}
+	cfg, err := loadConfig(*cfgPath)
+	if err != nil {
+		return err
+	}
+	p, ok := aoc.Lookup(*year, *day)
+	if !ok {
+		return fmt.Errorf("play: no solver registered for %d day %d", *year, *day)
+	}
+	s, ok := p.New().(aoc.Playable)
+	if !ok {
+		return fmt.Errorf("play: %d day %d cannot be played", *year, *day)
+	}
+	in := *input
+	if in == "" {
+		in = cfg.defaultInput(p, *root)
+	}
+	f, err := os.Open(in)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := s.Parse(f); err != nil {
+		return fmt.Errorf("%d day %d: parse: %w", p.Year, p.Day, err)
+	}
+	if isTerminal(os.Stdin) {
+		restore, err := rawMode(os.Stdin)
+		if err != nil {
+			return err
+		}
+		defer restore()
+		stop := onSignal(restore)
+		defer stop()
+	}
+	return s.Play(anim.NewKeyReader(os.Stdin), os.Stdout)
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/liviro/aoc/internal/anim"
+	"github.com/liviro/aoc/internal/aoc"
+)
+
+// runCmd runs the solver of a single day, or of every registered day of a
+// year in sequence.
+func runCmd(args []string) error {
+	fs := flag.NewFlagSet("run", flag.ContinueOnError)
+	year := fs.Int("year", 0, "puzzle year")
+	day := fs.Int("day", 0, "puzzle day; all registered days of the year if unset")
+	input := fs.String("input", "", "input file; <year>/dayNN/input.txt under -root, or the cached input, if unset")
+	root := fs.String("root", ".", "repository root, used to locate default inputs")
+	cfgPath := fs.String("config", defaultConfigPath(), "configuration file, locating the input cache")
+	params := paramsFlag{}
+	fs.Var(params, "param", "solver parameter override as name=value; may be repeated")
+	animate := fs.Bool("animate", false, "play the frames of the day's simulation in the terminal, or write them to -frames if stdout is not a terminal")
+	fps := fs.Float64("fps", 10, "frames per second to start -animate at, or of the -render GIF")
+	frames := fs.String("frames", "frames.txt", "file to write the frames of -animate to when stdout is not a terminal")
+	render := fs.String("render", "", "draw the frames of the day's simulation into an animated .gif, or into numbered .png files")
+	cell := fs.Int("cell", 4, "size in pixels of the cells drawn by -render")
+	every := fs.Int("every", 1, "draw only every nth frame with -render")
+	maxFrames := fs.Int("max-frames", 0, "draw at most this many frames with -render; all if 0")
+	palette := anim.DefaultPalette()
+	fs.Var(palette, "palette", "colors of the cells drawn by -render, as rune=rrggbb; may be comma-separated")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *year == 0 {
+		return errors.New("run: -year is required")
+	}
+	cfg, err := loadConfig(*cfgPath)
+	if err != nil {
+		return err
+	}
+
+	if *day == 0 {
+		if *input != "" {
+			return errors.New("run: -input requires -day")
+		}
+		if len(params) > 0 {
+			return errors.New("run: -param requires -day")
+		}
+		if *animate || *render != "" {
+			return errors.New("run: -animate and -render require -day")
+		}
+		ps := aoc.Days(*year)
+		if len(ps) == 0 {
+			return fmt.Errorf("run: no solvers registered for %d", *year)
+		}
+		for _, p := range ps {
+			fmt.Printf("== %d day %d ==\n", p.Year, p.Day)
+			if err := runPuzzle(p, cfg.defaultInput(p, *root), nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	p, ok := aoc.Lookup(*year, *day)
+	if !ok {
+		return fmt.Errorf("run: no solver registered for %d day %d", *year, *day)
+	}
+	in := *input
+	if in == "" {
+		in = cfg.defaultInput(p, *root)
+	}
+	if *animate || *render != "" {
+		if _, ok := p.New().(aoc.Animated); !ok {
+			return fmt.Errorf("run: %d day %d has no animation", p.Year, p.Day)
+		}
+	}
+	switch {
+	case *animate && *render != "":
+		return errors.New("run: -animate and -render are exclusive")
+	case *animate:
+		return playPuzzle(p, in, params, *fps, *frames)
+	case *render != "":
+		return renderPuzzle(p, in, params, *render, renderOptions{palette, *cell, *fps, *every, *maxFrames})
+	}
+	return runPuzzle(p, in, params)
+}
+
+func runPuzzle(p aoc.Puzzle, input string, params map[string]int) error {
+	f, err := os.Open(input)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	p1, p2, err := p.Solve(f, params)
+	if err != nil {
+		return fmt.Errorf("%d day %d: %w", p.Year, p.Day, err)
+	}
+	printAnswer(1, p1)
+	printAnswer(2, p2)
+	return nil
+}
+
+// animatePuzzle solves a day as runPuzzle does, with the frames of its
+// simulation emitted into frames.
+func animatePuzzle(p aoc.Puzzle, input string, params map[string]int, frames anim.Sink) error {
+	f, err := os.Open(input)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	p1, p2, err := p.Animate(f, params, frames)
+	if err != nil {
+		return fmt.Errorf("%d day %d: %w", p.Year, p.Day, err)
+	}
+	printAnswer(1, p1)
+	printAnswer(2, p2)
+	return nil
+}
+
+// playPuzzle animates a day, playing its frames in the terminal, with keys
+// read from stdin, or writing them to the frames file if stdout is not a
+// terminal.
+func playPuzzle(p aoc.Puzzle, input string, params map[string]int, fps float64, frames string) error {
+	if !isTerminal(os.Stdout) {
+		out, err := os.Create(frames)
+		if err != nil {
+			return err
+		}
+		dump := anim.NewDump(out)
+		err = animatePuzzle(p, input, params, dump)
+		n, derr := dump.Frames()
+		if cerr := out.Close(); derr == nil {
+			derr = cerr
+		}
+		if err != nil {
+			return err
+		}
+		if derr != nil {
+			return derr
+		}
+		fmt.Printf("%d frames written to %s\n", n, frames)
+		return nil
+	}
+	var keys <-chan byte
+	restore := func() {}
+	if isTerminal(os.Stdin) {
+		var err error
+		if restore, err = rawMode(os.Stdin); err != nil {
+			return err
+		}
+		defer restore()
+		keys = anim.Keys(os.Stdin)
+	}
+	player := anim.NewPlayer(os.Stdout, fps, keys)
+	defer player.Close()
+	// The player hides the cursor, whether or not keys come from a terminal.
+	stop := onSignal(func() {
+		player.Close()
+		restore()
+	})
+	defer stop()
+	return animatePuzzle(p, input, params, player)
+}
+
+// renderOptions says how renderPuzzle draws frames, and which of them.
+type renderOptions struct {
+	palette      anim.Palette
+	cell         int
+	fps          float64
+	every, limit int
+}
+
+// renderPuzzle animates a day, drawing its frames as images into out as they
+// are emitted: an animated GIF if it ends in .gif, or else, if it ends in
+// .png, one PNG per frame, numbered from 1 after its base name.
+func renderPuzzle(p aoc.Puzzle, input string, params map[string]int, out string, o renderOptions) error {
+	ext := filepath.Ext(out)
+	if ext != ".gif" && ext != ".png" {
+		return fmt.Errorf("run: cannot render to %s: want a .gif or .png file", out)
+	}
+	if ext == ".png" {
+		base := strings.TrimSuffix(out, ext)
+		pngs := anim.NewPNGs(o.palette, o.cell, func(i int) (io.WriteCloser, error) {
+			return os.Create(fmt.Sprintf("%s-%05d.png", base, i+1))
+		})
+		err := animatePuzzle(p, input, params, anim.NewSample(pngs, o.every, o.limit))
+		n, perr := pngs.Frames()
+		if err != nil {
+			return err
+		}
+		if perr != nil {
+			return perr
+		}
+		fmt.Printf("%d frames written to %s-*.png\n", n, base)
+		return nil
+	}
+	f, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+	g := anim.NewGIF(f, o.palette, o.cell, o.fps)
+	err = animatePuzzle(p, input, params, anim.NewSample(g, o.every, o.limit))
+	gerr := g.Close()
+	if cerr := f.Close(); gerr == nil {
+		gerr = cerr
+	}
+	if err != nil {
+		return err
+	}
+	if gerr != nil {
+		return gerr
+	}
+	fmt.Printf("%d frames written to %s\n", g.Len(), out)
+	return nil
+}
+
+func printAnswer(part int, a aoc.Answer) {
+	switch {
+	case a.IsZero():
+		fmt.Printf("Part %d: -\n", part)
+	case a.IsRender():
+		fmt.Printf("Part %d:\n%s\n", part, strings.TrimRight(a.String(), "\n"))
+	default:
+		fmt.Printf("Part %d: %s\n", part, a)
+	}
+}
+
+// paramsFlag collects repeated name=value solver parameter overrides.
+type paramsFlag map[string]int
+
+func (f paramsFlag) String() string {
+	var kvs []string
+	for k, v := range f {
+		kvs = append(kvs, fmt.Sprintf("%s=%d", k, v))
+	}
+	return strings.Join(kvs, ",")
+}
+
+func (f paramsFlag) Set(s string) error {
+	k, v, ok := strings.Cut(s, "=")
+	if !ok || k == "" {
+		return fmt.Errorf("parameter %q is not name=value", s)
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return fmt.Errorf("parameter %s: %w", k, err)
+	}
+	f[k] = n
+	return nil
+}
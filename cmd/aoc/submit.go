@@ -0,0 +1,135 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/site"
+)
+
+// submitCmd solves one part of a puzzle and submits the answer to the site,
+// recording the attempt in the submission history. Answers known to be wrong
+// are not submitted again, and correct ones are added to the answers
+// manifest.
+func submitCmd(args []string) error {
+	fs := flag.NewFlagSet("submit", flag.ContinueOnError)
+	year := fs.Int("year", 0, "puzzle year")
+	day := fs.Int("day", 0, "puzzle day")
+	part := fs.Int("part", 0, "puzzle part, 1 or 2")
+	answer := fs.String("answer", "", "answer to submit as is, rather than solving the part")
+	input := fs.String("input", "", "input file; <year>/dayNN/input.txt under -root, or the cached input, if unset")
+	root := fs.String("root", ".", "repository root, used to locate default inputs and the history")
+	cfgPath := fs.String("config", defaultConfigPath(), "configuration file")
+	params := paramsFlag{}
+	fs.Var(params, "param", "solver parameter override as name=value; may be repeated")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *year == 0 || *day == 0 || *part == 0 {
+		return errors.New("submit: -year, -day and -part are required")
+	}
+	if *part != 1 && *part != 2 {
+		return fmt.Errorf("submit: no part %d", *part)
+	}
+	cfg, err := loadConfig(*cfgPath)
+	if err != nil {
+		return err
+	}
+
+	ans := *answer
+	if ans == "" {
+		p, ok := aoc.Lookup(*year, *day)
+		if !ok {
+			return fmt.Errorf("submit: no solver registered for %d day %d", *year, *day)
+		}
+		in := *input
+		if in == "" {
+			in = cfg.defaultInput(p, *root)
+		}
+		if ans, err = solvePart(p, in, params, *part); err != nil {
+			return err
+		}
+	}
+
+	histPath := cfg.historyPath(*root)
+	h, err := site.LoadHistory(histPath)
+	if err != nil {
+		return err
+	}
+	warnings, err := h.Check(*year, *day, *part, ans)
+	if err != nil {
+		return fmt.Errorf("submit: not submitting: %w", err)
+	}
+	for _, w := range warnings {
+		fmt.Fprintln(os.Stderr, "aoc: warning:", w)
+	}
+
+	fmt.Printf("Submitting %s to %d day %d part %d\n", ans, *year, *day, *part)
+	v, err := cfg.client().Submit(*year, *day, *part, ans)
+	if err != nil {
+		return fmt.Errorf("submit: %w", err)
+	}
+	h = append(h, site.Attempt{Year: *year, Day: *day, Part: *part, Answer: ans, Outcome: v.Outcome, Time: time.Now()})
+	if err := h.Save(histPath); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s: %s\n", v.Outcome, v.Message)
+	if v.Wait > 0 {
+		fmt.Printf("Wait %s before the next attempt.\n", v.Wait)
+	}
+	if v.Outcome == site.Correct {
+		return recordAnswer(*root, *year, *day, *part, ans)
+	}
+	return nil
+}
+
+func solvePart(p aoc.Puzzle, input string, params map[string]int, part int) (string, error) {
+	f, err := os.Open(input)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	a, err := p.SolvePart(f, params, part)
+	if err != nil {
+		return "", fmt.Errorf("%d day %d: %w", p.Year, p.Day, err)
+	}
+	switch {
+	case a.IsZero():
+		return "", fmt.Errorf("%d day %d part %d has no answer to submit", p.Year, p.Day, part)
+	case a.IsRender():
+		printAnswer(part, a)
+		return "", errors.New("submit: read the answer off the rendering, and pass it with -answer")
+	}
+	return a.String(), nil
+}
+
+// recordAnswer adds a correct answer to the answers manifest under root.
+func recordAnswer(root string, year, day, part int, answer string) error {
+	path := filepath.Join(root, aoc.AnswersPath)
+	answers, err := aoc.LoadAnswers(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		answers, err = aoc.Answers{}, nil
+	}
+	if err != nil {
+		return err
+	}
+	e, _ := answers.Get(year, day)
+	if e.Part(part) == answer {
+		return nil
+	}
+	if part == 1 {
+		e.Part1 = answer
+	} else {
+		e.Part2 = answer
+	}
+	answers.Set(year, day, e)
+	fmt.Printf("Recorded in %s\n", path)
+	return answers.Save(path)
+}
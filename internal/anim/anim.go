@@ -0,0 +1,82 @@
+// Package anim lets simulation-style solvers emit the states of their
+// simulation as frames, to be played back in a terminal or dumped to a file.
+package anim
+
+import (
+	"fmt"
+	"io"
+)
+
+// Frame is a single state of a simulation, drawn as text, with a caption
+// telling which step it is.
+type Frame struct {
+	Caption string
+	Text    string
+}
+
+// Sink receives the frames of a simulation as it runs.
+type Sink interface {
+	Emit(Frame)
+}
+
+// Emit draws a frame with render and hands it to s, unless s is nil, in which
+// case render is not called. Solvers call it at every step so that nothing is
+// drawn unless the run is animated.
+func Emit(s Sink, caption string, render func() string) {
+	if s == nil {
+		return
+	}
+	s.Emit(Frame{Caption: caption, Text: render()})
+}
+
+// Dump writes frames one after the other, each under a header line with its
+// number and caption.
+type Dump struct {
+	w   io.Writer
+	n   int
+	err error
+}
+
+// NewDump returns a sink writing frames to w.
+func NewDump(w io.Writer) *Dump {
+	return &Dump{w: w}
+}
+
+func (d *Dump) Emit(f Frame) {
+	if d.err != nil {
+		return
+	}
+	d.n++
+	_, d.err = fmt.Fprintf(d.w, "== frame %d: %s ==\n%s\n", d.n, f.Caption, f.Text)
+}
+
+// Frames returns the number of frames written, and the first error writing
+// them.
+func (d *Dump) Frames() (int, error) {
+	return d.n, d.err
+}
+
+// Sample passes every nth frame emitted on to another sink, starting with the
+// first, and at most limit of them if limit is positive, so that long
+// simulations make for animations of a usable size.
+type Sample struct {
+	s            Sink
+	every, limit int
+	seen, kept   int
+}
+
+// NewSample returns a sink passing every nth frame, up to limit of them, on
+// to s.
+func NewSample(s Sink, every, limit int) *Sample {
+	return &Sample{s: s, every: max(every, 1), limit: limit}
+}
+
+func (sm *Sample) Emit(f Frame) {
+	i := sm.seen
+	sm.seen++
+	if i%sm.every != 0 || (sm.limit > 0 && sm.kept >= sm.limit) {
+		return
+	}
+	sm.kept++
+	sm.s.Emit(f)
+}
@@ -0,0 +1,131 @@
+package anim
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEmitNil(t *testing.T) {
+	Emit(nil, "never", func() string {
+		t.Error("rendered a frame without a sink")
+		return ""
+	})
+}
+
+func TestDump(t *testing.T) {
+	var b strings.Builder
+	d := NewDump(&b)
+	Emit(d, "tick 1", func() string { return "#.\n.#\n" })
+	Emit(d, "tick 2", func() string { return ".#\n#.\n" })
+	if n, err := d.Frames(); n != 2 || err != nil {
+		t.Errorf("Frames() = %d, %v, want 2 frames", n, err)
+	}
+	want := "== frame 1: tick 1 ==\n#.\n.#\n\n== frame 2: tick 2 ==\n.#\n#.\n\n"
+	if b.String() != want {
+		t.Errorf("dumped %q, want %q", b.String(), want)
+	}
+}
+
+func TestSample(t *testing.T) {
+	for _, tc := range []struct {
+		every, limit int
+		want         string
+	}{
+		{1, 0, "0123456789"},
+		{0, 0, "0123456789"},
+		{3, 0, "0369"},
+		{1, 4, "0123"},
+		{4, 2, "04"},
+	} {
+		var b strings.Builder
+		d := NewDump(&b)
+		sm := NewSample(d, tc.every, tc.limit)
+		for i := range 10 {
+			Emit(sm, "", func() string { return string(rune('0' + i)) })
+		}
+		var got strings.Builder
+		for _, l := range strings.Split(b.String(), "\n") {
+			if len(l) == 1 {
+				got.WriteString(l)
+			}
+		}
+		if got.String() != tc.want {
+			t.Errorf("every %d, limit %d: passed on %s, want %s", tc.every, tc.limit, got.String(), tc.want)
+		}
+	}
+}
+
+// play emits the frames to a player driven by the given keys, sent one at a
+// time as soon as the player takes them, and returns what it drew.
+func play(t *testing.T, fps float64, keys string, frames int) string {
+	t.Helper()
+	var b strings.Builder
+	ch := make(chan byte)
+	p := NewPlayer(&b, fps, ch)
+	go func() {
+		for _, k := range []byte(keys) {
+			ch <- k
+		}
+		close(ch)
+	}()
+	done := make(chan struct{})
+	go func() {
+		for i := range frames {
+			Emit(p, "step "+string(rune('0'+i)), func() string { return "..\n" })
+		}
+		p.Close()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the player did not get through the frames")
+	}
+	return b.String()
+}
+
+func TestPlayerPauseStepQuit(t *testing.T) {
+	// Very slow, so that frames only move on through keys: pause at the
+	// first, step twice, resume at the third and stop at the fourth.
+	out := play(t, MinFPS, " nn q", 6)
+	for _, want := range []string{
+		hideCursor + clear + home + "frame 1: step 0 [0.25 fps]",
+		"frame 2: step 1 [paused]",
+		"frame 3: step 2 [paused]",
+		"frame 4: step 3 [0.25 fps]",
+		"..\x1b[K\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("player output lacks %q:\n%q", want, out)
+		}
+	}
+	if strings.Contains(out, "frame 5") {
+		t.Errorf("player went on after q:\n%q", out)
+	}
+	if !strings.HasSuffix(out, showCursor) {
+		t.Errorf("player did not show the cursor again:\n%q", out)
+	}
+}
+
+func TestPlayerSpeed(t *testing.T) {
+	out := play(t, 1, "+++++++++++", 3)
+	if !strings.Contains(out, "[1e+03 fps]") {
+		t.Errorf("player did not speed up to its maximum:\n%q", out)
+	}
+}
+
+func TestKeyReader(t *testing.T) {
+	kr := NewKeyReader(strings.NewReader("^\x1b[A\x1b[D<\x1b[Zq\x1b"))
+	want := []Key{'^', ArrowUp, ArrowLeft, '<', '\x1b', '[', 'Z', 'q', '\x1b'}
+	for i, w := range want {
+		k, err := kr.ReadKey()
+		if err != nil || k != w {
+			t.Fatalf("key %d: got %q, %v, want %q", i, k, err, w)
+		}
+	}
+	if _, err := kr.ReadKey(); err != io.EOF {
+		t.Errorf("got %v after the last key, want EOF", err)
+	}
+}
@@ -0,0 +1,313 @@
+package anim
+
+import (
+	"bufio"
+	"bytes"
+	"compress/lzw"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/png"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Palette maps the kinds of cells, the runes of a frame's text, to the colors
+// they are drawn in. Runes it misses get a color of their own, picked from
+// the web-safe palette. As a flag, it is set from a comma-separated list of
+// rune=rrggbb entries, overriding those already in it.
+type Palette map[rune]color.Color
+
+// pad is the rune frames are padded with, up to the largest of them.
+const pad = ' '
+
+// DefaultPalette returns the colors of the cells drawn by the solvers: walls,
+// boxes, robots, the counts of robots sharing a spot, and so on.
+func DefaultPalette() Palette {
+	p := Palette{
+		pad: color.RGBA{0x00, 0x00, 0x00, 0xff},
+		'.': color.RGBA{0x10, 0x10, 0x20, 0xff},
+		'#': color.RGBA{0x80, 0x80, 0x80, 0xff},
+		'@': color.RGBA{0xff, 0xd7, 0x00, 0xff},
+		'O': color.RGBA{0xcd, 0x85, 0x3f, 0xff},
+		'[': color.RGBA{0xcd, 0x85, 0x3f, 0xff},
+		']': color.RGBA{0xa0, 0x52, 0x2d, 0xff},
+		'X': color.RGBA{0x1e, 0x90, 0xff, 0xff},
+		'x': color.RGBA{0x40, 0x40, 0x60, 0xff},
+		'S': color.RGBA{0x00, 0xc0, 0x00, 0xff},
+		'E': color.RGBA{0xff, 0x30, 0x30, 0xff},
+		'*': color.RGBA{0xff, 0xff, 0xff, 0xff},
+	}
+	for _, r := range "^>v<" {
+		p[r] = color.RGBA{0xff, 0x30, 0x30, 0xff}
+	}
+	for d := range 9 {
+		// Greener the more there are.
+		p[rune('1'+d)] = color.RGBA{0x00, uint8(0x60 + 0x18*d), 0x30, 0xff}
+	}
+	return p
+}
+
+func (p Palette) color(r rune) color.Color {
+	if c, ok := p[r]; ok {
+		return c
+	}
+	return palette.WebSafe[int(r)%len(palette.WebSafe)]
+}
+
+func (p Palette) String() string {
+	var es []string
+	for _, r := range slices.Sorted(maps.Keys(p)) {
+		c := color.RGBAModel.Convert(p[r]).(color.RGBA)
+		es = append(es, fmt.Sprintf("%c=%02x%02x%02x", r, c.R, c.G, c.B))
+	}
+	return strings.Join(es, ",")
+}
+
+func (p Palette) Set(s string) error {
+	for _, e := range strings.Split(s, ",") {
+		k, v, ok := strings.Cut(e, "=")
+		rs := []rune(k)
+		if !ok || len(rs) != 1 {
+			return fmt.Errorf("palette entry %q: want rune=rrggbb", e)
+		}
+		hex := strings.TrimPrefix(v, "#")
+		rgb, err := strconv.ParseUint(hex, 16, 24)
+		if err != nil || len(hex) != 6 {
+			return fmt.Errorf("palette entry %q: bad color %q", e, v)
+		}
+		p[rs[0]] = color.RGBA{uint8(rgb >> 16), uint8(rgb >> 8), uint8(rgb), 0xff}
+	}
+	return nil
+}
+
+// images draws frames as they are emitted, with each rune of their text a
+// square cell of the given size, colored by the palette. Frames smaller than
+// one drawn before them are padded to its size.
+type images struct {
+	palette Palette
+	cell    int
+	// The size, in cells, of the largest frame yet.
+	cols, rows int
+}
+
+// draw draws a frame, in the colors of the runes it holds, the pad's first.
+func (im *images) draw(f Frame) (*image.Paletted, error) {
+	ls := lines(f.Text)
+	im.rows = max(im.rows, len(ls))
+	runes := map[rune]bool{}
+	for _, l := range ls {
+		im.cols = max(im.cols, len(l))
+		for _, r := range l {
+			if r != pad {
+				runes[r] = true
+			}
+		}
+	}
+	if len(runes)+1 > 256 {
+		return nil, fmt.Errorf("%d kinds of cells, more than the 256 colors of an image", len(runes)+1)
+	}
+	cp := color.Palette{im.palette.color(pad)}
+	index := map[rune]uint8{pad: 0}
+	for _, r := range slices.Sorted(maps.Keys(runes)) {
+		index[r] = uint8(len(cp))
+		cp = append(cp, im.palette.color(r))
+	}
+	// The pad's color fills in, as it comes first.
+	img := image.NewPaletted(image.Rect(0, 0, im.cols*im.cell, im.rows*im.cell), cp)
+	for y, l := range ls {
+		for x, r := range l {
+			c := index[r]
+			for py := y * im.cell; py < (y+1)*im.cell; py++ {
+				row := img.Pix[py*img.Stride:]
+				for px := x * im.cell; px < (x+1)*im.cell; px++ {
+					row[px] = c
+				}
+			}
+		}
+	}
+	return img, nil
+}
+
+// lines splits the text of a frame into its rows of runes.
+func lines(text string) [][]rune {
+	var ls [][]rune
+	for _, l := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+		ls = append(ls, []rune(l))
+	}
+	return ls
+}
+
+// GIF writes frames into an animated GIF as they are emitted, looping forever,
+// showing each frame for 1/fps seconds, within what GIF delays can express.
+// Only the frame being drawn is held in memory. The size of the GIF is that
+// of its largest frame, which is only known at the end, so it is written
+// into the GIF's header then.
+type GIF struct {
+	images
+	w     io.WriteSeeker
+	b     *bufio.Writer
+	delay int
+	n     int
+	err   error
+}
+
+// NewGIF returns a sink writing frames to w as a GIF, with cells of the given
+// size in pixels. Close finishes the GIF.
+func NewGIF(w io.WriteSeeker, p Palette, cell int, fps float64) *GIF {
+	return &GIF{
+		images: images{palette: p, cell: max(cell, 1)},
+		w:      w,
+		b:      bufio.NewWriter(w),
+		delay:  min(max(int(100/fps+0.5), 1), 65535),
+	}
+}
+
+func (g *GIF) Emit(f Frame) {
+	if g.err != nil {
+		return
+	}
+	img, err := g.draw(f)
+	if err != nil {
+		g.err = err
+		return
+	}
+	if g.n == 0 {
+		g.header()
+	}
+	g.n++
+	g.frame(img)
+}
+
+// Len returns the number of frames written.
+func (g *GIF) Len() int {
+	return g.n
+}
+
+// header writes the start of the GIF: its size, to be set by Close, a global
+// color table of the pad's color, shown where no frame has been drawn yet,
+// and the extension making it loop forever.
+func (g *GIF) header() {
+	g.b.WriteString("GIF89a")
+	g.b.Write([]byte{0, 0, 0, 0})
+	// A global color table of 2 colors, and the first of them behind.
+	g.b.Write([]byte{0x80 | 7<<4, 0, 0})
+	r, gr, b, _ := g.palette.color(pad).RGBA()
+	g.b.Write([]byte{byte(r >> 8), byte(gr >> 8), byte(b >> 8), 0, 0, 0})
+	g.b.Write([]byte{0x21, 0xff, 11})
+	g.b.WriteString("NETSCAPE2.0")
+	g.b.Write([]byte{3, 1, 0, 0, 0})
+}
+
+// frame writes an image with its delay and its own color table.
+func (g *GIF) frame(img *image.Paletted) {
+	bits := 1
+	for 1<<bits < len(img.Palette) {
+		bits++
+	}
+	w, h := img.Rect.Dx(), img.Rect.Dy()
+	g.b.Write([]byte{0x21, 0xf9, 4, 0, byte(g.delay), byte(g.delay >> 8), 0, 0})
+	g.b.Write([]byte{0x2c, 0, 0, 0, 0, byte(w), byte(w >> 8), byte(h), byte(h >> 8), 0x80 | byte(bits-1)})
+	for i := range 1 << bits {
+		var rgb [3]byte
+		if i < len(img.Palette) {
+			r, gr, b, _ := img.Palette[i].RGBA()
+			rgb = [3]byte{byte(r >> 8), byte(gr >> 8), byte(b >> 8)}
+		}
+		g.b.Write(rgb[:])
+	}
+
+	// The pixels, compressed, in blocks of at most 255 bytes.
+	litWidth := max(bits, 2)
+	var data bytes.Buffer
+	lw := lzw.NewWriter(&data, lzw.LSB, litWidth)
+	lw.Write(img.Pix)
+	lw.Close()
+	g.b.WriteByte(byte(litWidth))
+	for bs := data.Bytes(); len(bs) > 0; {
+		n := min(len(bs), 255)
+		g.b.WriteByte(byte(n))
+		g.b.Write(bs[:n])
+		bs = bs[n:]
+	}
+	g.b.WriteByte(0)
+}
+
+// Close ends the GIF, and writes its size, that of the largest frame, into
+// its header. It returns the first error drawing or writing the frames.
+func (g *GIF) Close() error {
+	if g.err != nil {
+		return g.err
+	}
+	if g.n == 0 {
+		return errors.New("no frames")
+	}
+	w, h := g.cols*g.cell, g.rows*g.cell
+	if w > 65535 || h > 65535 {
+		return fmt.Errorf("%dx%d pixels, more than a GIF can hold", w, h)
+	}
+	g.b.WriteByte(0x3b)
+	if err := g.b.Flush(); err != nil {
+		return err
+	}
+	end, err := g.w.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := g.w.Seek(6, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := g.w.Write([]byte{byte(w), byte(w >> 8), byte(h), byte(h >> 8)}); err != nil {
+		return err
+	}
+	_, err = g.w.Seek(end, io.SeekStart)
+	return err
+}
+
+// PNGs writes each frame as a PNG as it is emitted, into the writer returned
+// by create for its index, which it closes.
+type PNGs struct {
+	images
+	create func(i int) (io.WriteCloser, error)
+	n      int
+	err    error
+}
+
+// NewPNGs returns a sink writing frames as PNGs, with cells of the given size
+// in pixels.
+func NewPNGs(p Palette, cell int, create func(i int) (io.WriteCloser, error)) *PNGs {
+	return &PNGs{images: images{palette: p, cell: max(cell, 1)}, create: create}
+}
+
+func (ps *PNGs) Emit(f Frame) {
+	if ps.err != nil {
+		return
+	}
+	img, err := ps.draw(f)
+	if err != nil {
+		ps.err = err
+		return
+	}
+	w, err := ps.create(ps.n)
+	if err != nil {
+		ps.err = err
+		return
+	}
+	ps.n++
+	err = png.Encode(w, img)
+	if cerr := w.Close(); err == nil {
+		err = cerr
+	}
+	ps.err = err
+}
+
+// Frames returns the number of frames written, and the first error drawing or
+// writing them.
+func (ps *PNGs) Frames() (int, error) {
+	return ps.n, ps.err
+}
@@ -0,0 +1,207 @@
+package anim
+
+import (
+	"bytes"
+	"flag"
+	"image"
+	"image/color"
+	"image/gif"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// golden compares got with the golden file of the given name, or rewrites it
+// with -update.
+func golden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s differs", name)
+	}
+}
+
+// tiny emits two frames of a robot pushing a box, the second one smaller than
+// the first.
+func tiny(s Sink) {
+	Emit(s, "move 0", func() string { return "#####\n#@O.#\n#####\n" })
+	Emit(s, "move 1", func() string { return "#.@O\n" })
+}
+
+// writeGIF writes the frames emitted by emit into a GIF, and returns it.
+func writeGIF(t *testing.T, cell int, fps float64, emit func(Sink)) ([]byte, error) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "out.gif")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := NewGIF(f, DefaultPalette(), cell, fps)
+	emit(g)
+	err = g.Close()
+	if cerr := f.Close(); cerr != nil {
+		t.Fatal(cerr)
+	}
+	b, rerr := os.ReadFile(path)
+	if rerr != nil {
+		t.Fatal(rerr)
+	}
+	return b, err
+}
+
+type nopCloser struct{ io.Writer }
+
+func (nopCloser) Close() error { return nil }
+
+func TestGIF(t *testing.T) {
+	b, err := writeGIF(t, 3, 4, tiny)
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden(t, "tiny.gif", b)
+
+	g, err := gif.DecodeAll(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Image) != 2 || g.Delay[0] != 25 || g.LoopCount != 0 {
+		t.Fatalf("got %d frames with a delay of %v, looping %d times, want 2 with 25, looping forever", len(g.Image), g.Delay, g.LoopCount)
+	}
+	if g.Config.Width != 15 || g.Config.Height != 9 {
+		t.Errorf("got a %dx%d GIF, want 15x9", g.Config.Width, g.Config.Height)
+	}
+	p := DefaultPalette()
+	for _, tc := range []struct {
+		frame, x, y int
+		r           rune
+	}{
+		{0, 0, 0, '#'},
+		{0, 5, 5, '@'},
+		{0, 8, 3, 'O'},
+		{0, 14, 8, '#'},
+		{1, 8, 2, '@'},
+		{1, 11, 0, 'O'},
+		// Padding, up to the size of the first frame.
+		{1, 14, 2, pad},
+		{1, 0, 3, pad},
+	} {
+		got := color.RGBAModel.Convert(g.Image[tc.frame].At(tc.x, tc.y))
+		if want := color.RGBAModel.Convert(p[tc.r]); got != want {
+			t.Errorf("frame %d at (%d, %d) = %v, want %v for %q", tc.frame, tc.x, tc.y, got, want, tc.r)
+		}
+	}
+}
+
+func TestPNGs(t *testing.T) {
+	p := DefaultPalette()
+	if err := p.Set("@=ff00ff,.=#ffffff"); err != nil {
+		t.Fatal(err)
+	}
+	var bs []*bytes.Buffer
+	ps := NewPNGs(p, 2, func(i int) (io.WriteCloser, error) {
+		if i != len(bs) {
+			t.Errorf("writing frame %d after %d", i, len(bs))
+		}
+		bs = append(bs, &bytes.Buffer{})
+		return nopCloser{bs[i]}, nil
+	})
+	tiny(ps)
+	if n, err := ps.Frames(); n != 2 || err != nil {
+		t.Fatalf("Frames() = %d, %v, want 2 frames", n, err)
+	}
+	if len(bs) != 2 {
+		t.Fatalf("wrote %d PNGs, want 2", len(bs))
+	}
+	golden(t, "tiny-1.png", bs[0].Bytes())
+	golden(t, "tiny-2.png", bs[1].Bytes())
+
+	img, _, err := image.Decode(bytes.NewReader(bs[0].Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := img.Bounds(); b.Dx() != 10 || b.Dy() != 6 {
+		t.Errorf("got a %dx%d image, want 10x6", b.Dx(), b.Dy())
+	}
+	if got := color.RGBAModel.Convert(img.At(2, 2)); got != (color.RGBA{0xff, 0x00, 0xff, 0xff}) {
+		t.Errorf("robot drawn in %v, want the overridden ff00ff", got)
+	}
+	if got := color.RGBAModel.Convert(img.At(6, 2)); got != (color.RGBA{0xff, 0xff, 0xff, 0xff}) {
+		t.Errorf("floor drawn in %v, want the overridden ffffff", got)
+	}
+}
+
+func TestPaletteSet(t *testing.T) {
+	for _, s := range []string{"", "@", "ab=ffffff", "@=fff", "@=gggggg", "@=ffffff,"} {
+		if err := (Palette{}).Set(s); err == nil {
+			t.Errorf("Set(%q) succeeded", s)
+		}
+	}
+	p := Palette{}
+	if err := p.Set("#=102030,.=#000000"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := p.String(), "#=102030,.=000000"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestGIFGrows(t *testing.T) {
+	// The GIF takes the size of its largest frame, the last one, with the
+	// earlier ones showing the pad's color around them.
+	b, err := writeGIF(t, 2, 10, func(s Sink) {
+		for i := 1; i <= 3; i++ {
+			Emit(s, "", func() string { return strings.Repeat(strings.Repeat("@", i)+"\n", i) })
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	g, err := gif.DecodeAll(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Config.Width != 6 || g.Config.Height != 6 || len(g.Image) != 3 {
+		t.Fatalf("got a %dx%d GIF of %d frames, want 6x6 of 3", g.Config.Width, g.Config.Height, len(g.Image))
+	}
+	for i, img := range g.Image {
+		if b := img.Bounds(); b.Dx() != 2*(i+1) || b.Dy() != 2*(i+1) {
+			t.Errorf("frame %d is %v, want %dx%d", i, b, 2*(i+1), 2*(i+1))
+		}
+	}
+	if bg := color.RGBAModel.Convert(g.Config.ColorModel.(color.Palette)[g.BackgroundIndex]); bg != DefaultPalette()[pad] {
+		t.Errorf("background %v, want the pad's color", bg)
+	}
+}
+
+func TestGIFErrors(t *testing.T) {
+	var cells strings.Builder
+	for r := range rune(300) {
+		cells.WriteRune('A' + r)
+	}
+	for _, tc := range []struct {
+		name string
+		emit func(Sink)
+	}{
+		{"no frames", func(Sink) {}},
+		{"300 kinds of cells", func(s Sink) { Emit(s, "all", cells.String) }},
+		{"too wide", func(s Sink) { Emit(s, "wide", func() string { return strings.Repeat(".", 20000) }) }},
+	} {
+		if _, err := writeGIF(t, 4, 10, tc.emit); err == nil {
+			t.Errorf("%s: wrote a GIF", tc.name)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package anim
+
+import (
+	"bufio"
+	"io"
+)
+
+// Key is a key pressed: the rune typed, or one of the arrow keys, which
+// terminals send as escape sequences.
+type Key rune
+
+// The arrow keys, out of the range of runes.
+const (
+	ArrowUp Key = -1 - iota
+	ArrowDown
+	ArrowRight
+	ArrowLeft
+)
+
+// arrows maps the final bytes of the escape sequences of the arrow keys, sent
+// after ESC [, to the keys.
+var arrows = map[byte]Key{'A': ArrowUp, 'B': ArrowDown, 'C': ArrowRight, 'D': ArrowLeft}
+
+// KeyReader reads keys one at a time, from a terminal or from a script, as
+// games are played.
+type KeyReader interface {
+	ReadKey() (Key, error)
+}
+
+type keyReader struct {
+	r *bufio.Reader
+}
+
+// NewKeyReader returns a reader of the keys typed into r: a terminal in raw
+// mode, or a file or string of keys, escape sequences included.
+func NewKeyReader(r io.Reader) KeyReader {
+	return keyReader{bufio.NewReader(r)}
+}
+
+func (kr keyReader) ReadKey() (Key, error) {
+	r, _, err := kr.r.ReadRune()
+	if err != nil {
+		return 0, err
+	}
+	// An escape key pressed on its own comes without anything buffered
+	// after it, unlike the rest of an escape sequence.
+	if r != '\x1b' || kr.r.Buffered() < 2 {
+		return Key(r), nil
+	}
+	seq, _ := kr.r.Peek(2)
+	if k, ok := arrows[seq[1]]; ok && seq[0] == '[' {
+		kr.r.Discard(2)
+		return k, nil
+	}
+	return Key(r), nil
+}
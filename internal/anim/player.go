@@ -0,0 +1,143 @@
+package anim
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// ANSI escape sequences the player draws with.
+const (
+	hideCursor = "\x1b[?25l"
+	showCursor = "\x1b[?25h"
+	clear      = "\x1b[2J"
+	home       = "\x1b[H"
+	clearLine  = "\x1b[K"
+	clearBelow = "\x1b[J"
+)
+
+// Keys the player reacts to.
+const (
+	KeyPause  = ' '
+	KeyStep   = 'n'
+	KeyFaster = '+'
+	KeySlower = '-'
+	KeyQuit   = 'q'
+)
+
+// The bounds of the frame rate.
+const (
+	MinFPS = 0.25
+	MaxFPS = 1000
+)
+
+// Player plays frames in a terminal as they are emitted, redrawing the screen
+// in place and holding each frame for as long as the frame rate says. Keys
+// pause and resume, step through paused frames one at a time, change the
+// frame rate, or stop playing, after which frames are dropped.
+type Player struct {
+	out     io.Writer
+	fps     float64
+	keys    <-chan byte
+	paused  bool
+	stopped bool
+	n       int
+}
+
+// NewPlayer returns a player drawing to out, a terminal, at the given frame
+// rate, within MinFPS and MaxFPS, controlled by keys read from the channel,
+// which may be nil. It clears the screen and hides the cursor until Close.
+func NewPlayer(out io.Writer, fps float64, keys <-chan byte) *Player {
+	fmt.Fprint(out, hideCursor+clear)
+	return &Player{out: out, fps: min(max(fps, MinFPS), MaxFPS), keys: keys}
+}
+
+// Keys returns a channel of the bytes read from r, for a player's keys. It is
+// closed when reading fails.
+func Keys(r io.Reader) <-chan byte {
+	keys := make(chan byte)
+	go func() {
+		defer close(keys)
+		b := make([]byte, 1)
+		for {
+			if _, err := r.Read(b); err != nil {
+				return
+			}
+			keys <- b[0]
+		}
+	}()
+	return keys
+}
+
+func (p *Player) Emit(f Frame) {
+	if p.stopped {
+		return
+	}
+	p.n++
+	p.draw(f)
+	next := time.After(time.Duration(float64(time.Second) / p.fps))
+	for {
+		if p.paused {
+			next = nil
+		}
+		select {
+		case k, ok := <-p.keys:
+			if !ok {
+				// No more keys: play on.
+				p.keys, p.paused = nil, false
+				return
+			}
+			if done := p.key(k); done {
+				return
+			}
+		case <-next:
+			return
+		}
+		if !p.paused && next == nil {
+			// Resumed: move on to the next frame.
+			return
+		}
+	}
+}
+
+// key handles a key press while a frame is shown, and returns whether to go
+// on to the next frame.
+func (p *Player) key(k byte) bool {
+	switch k {
+	case KeyPause:
+		p.paused = !p.paused
+		return !p.paused
+	case KeyStep:
+		return p.paused
+	case KeyFaster:
+		p.fps = min(p.fps*2, MaxFPS)
+	case KeySlower:
+		p.fps = max(p.fps/2, MinFPS)
+	case KeyQuit:
+		p.stopped = true
+		return true
+	}
+	return false
+}
+
+func (p *Player) draw(f Frame) {
+	var b strings.Builder
+	b.WriteString(home)
+	state := fmt.Sprintf("%.3g fps", p.fps)
+	if p.paused {
+		state = "paused"
+	}
+	fmt.Fprintf(&b, "frame %d: %s [%s]%s\n", p.n, f.Caption, state, clearLine)
+	fmt.Fprintf(&b, "space: pause  n: step  +/-: speed  q: stop%s\n", clearLine)
+	for _, l := range strings.SplitAfter(strings.TrimRight(f.Text, "\n"), "\n") {
+		b.WriteString(strings.TrimSuffix(l, "\n") + clearLine + "\n")
+	}
+	b.WriteString(clearBelow)
+	io.WriteString(p.out, b.String())
+}
+
+// Close shows the cursor again.
+func (p *Player) Close() {
+	fmt.Fprint(p.out, showCursor)
+}
@@ -0,0 +1,63 @@
+package aoc
+
+import "strconv"
+
+type kind int
+
+const (
+	none kind = iota
+	integer
+	text
+	render
+)
+
+// Answer is the solution to one part of a puzzle. The zero Answer means the
+// part has no answer, as is the case for the second part of day 25.
+type Answer struct {
+	kind kind
+	n    int
+	s    string
+}
+
+// Int returns an integer answer.
+func Int(n int) Answer {
+	return Answer{kind: integer, n: n}
+}
+
+// Text returns a single-line textual answer, such as a comma-separated list
+// or a password.
+func Text(s string) Answer {
+	return Answer{kind: text, s: s}
+}
+
+// Render returns a multi-line answer, such as a picture drawn by the puzzle
+// that has to be read by a human.
+func Render(s string) Answer {
+	return Answer{kind: render, s: s}
+}
+
+// IsZero returns whether the answer is missing.
+func (a Answer) IsZero() bool {
+	return a.kind == none
+}
+
+// IsRender returns whether the answer is a multi-line rendering.
+func (a Answer) IsRender() bool {
+	return a.kind == render
+}
+
+// Int returns the value of an integer answer, and whether the answer is one.
+func (a Answer) Int() (int, bool) {
+	return a.n, a.kind == integer
+}
+
+// String returns the answer as it would be submitted.
+func (a Answer) String() string {
+	switch a.kind {
+	case integer:
+		return strconv.Itoa(a.n)
+	case text, render:
+		return a.s
+	}
+	return ""
+}
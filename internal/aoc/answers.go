@@ -0,0 +1,78 @@
+package aoc
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// AnswersPath is the location of the answers manifest, relative to the
+// repository root.
+const AnswersPath = "answers.json"
+
+// Expected holds the known answers to a puzzle, as they would be submitted.
+// An empty part is not known yet.
+type Expected struct {
+	Part1 string `json:"part1,omitempty"`
+	Part2 string `json:"part2,omitempty"`
+	// Slow marks puzzles that take minutes to solve, which the regression
+	// suite only runs on request.
+	Slow bool `json:"slow,omitempty"`
+}
+
+// Part returns the expected answer to the given part, 1 or 2.
+func (e Expected) Part(part int) string {
+	if part == 1 {
+		return e.Part1
+	}
+	return e.Part2
+}
+
+// Answers is the manifest of known answers, keyed by year and then by
+// two-digit day, so that the file lists days in order.
+type Answers map[string]map[string]Expected
+
+// LoadAnswers reads the answers manifest at path.
+func LoadAnswers(path string) (Answers, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	a := Answers{}
+	if err := json.Unmarshal(raw, &a); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return a, nil
+}
+
+// Get returns the known answers to the puzzle of the given year and day.
+func (a Answers) Get(year, day int) (Expected, bool) {
+	e, ok := a[strconv.Itoa(year)][fmt.Sprintf("%02d", day)]
+	return e, ok
+}
+
+// Set records the known answers to the puzzle of the given year and day.
+func (a Answers) Set(year, day int, e Expected) {
+	y := strconv.Itoa(year)
+	if a[y] == nil {
+		a[y] = map[string]Expected{}
+	}
+	a[y][fmt.Sprintf("%02d", day)] = e
+}
+
+// Save writes the manifest to path.
+func (a Answers) Save(path string) error {
+	raw, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(raw, '\n'), 0o644)
+}
+
+// Submission returns the answer in the form it is recorded in the manifest:
+// as it would be submitted, without the trailing newline of renderings.
+func Submission(a Answer) string {
+	return strings.TrimRight(a.String(), "\n")
+}
@@ -0,0 +1,172 @@
+// Package aoc holds the registry that every day's solver registers into, so
+// that a single runner can dispatch to any year and day.
+package aoc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strconv"
+
+	"github.com/liviro/aoc/internal/anim"
+)
+
+// Solver solves both parts of a puzzle.
+//
+// Parse is called once with the puzzle input before either part is solved.
+// The parts must leave the parsed input untouched, so that they can be run in
+// any order and any number of times.
+type Solver interface {
+	Parse(r io.Reader) error
+	Part1() (Answer, error)
+	Part2() (Answer, error)
+}
+
+// Debugger is implemented by solvers that offer an interactive tool to
+// explore their parsed input, such as a step debugger for a puzzle's program.
+// Debug reads commands from in until it ends, writing to out.
+type Debugger interface {
+	Solver
+	Debug(in io.Reader, out io.Writer) error
+}
+
+// Animated is implemented by solvers whose parts are simulations, which can
+// emit a frame at each step. Animate is called before either part is solved,
+// with the sink to emit the frames into.
+type Animated interface {
+	Solver
+	Animate(frames anim.Sink)
+}
+
+// Playable is implemented by solvers whose puzzle can be played by hand,
+// with the keys read from keys, and the game drawn to out. Play is called
+// after Parse, and returns when the keys run out or the player quits.
+type Playable interface {
+	Solver
+	Play(keys anim.KeyReader, out io.Writer) error
+}
+
+// Puzzle is a registered solver for a given year and day.
+type Puzzle struct {
+	Year, Day int
+	// New returns a fresh solver, ready to parse an input.
+	New func() Solver
+}
+
+// Dir returns the directory holding the puzzle's code and inputs, relative to
+// the repository root.
+func (p Puzzle) Dir() string {
+	return filepath.Join(strconv.Itoa(p.Year), fmt.Sprintf("day%02d", p.Day))
+}
+
+// InputPath returns the default location of the puzzle's input, relative to
+// the repository root.
+func (p Puzzle) InputPath() string {
+	return filepath.Join(p.Dir(), "input.txt")
+}
+
+// Solve parses the input with a fresh solver, whose parameters are overridden
+// by params, and solves both parts.
+func (p Puzzle) Solve(r io.Reader, params map[string]int) (part1, part2 Answer, err error) {
+	return p.solve(p.New(), r, params)
+}
+
+// Animate solves both parts as Solve does, with the frames of the solver's
+// simulations emitted into frames. The solver must be Animated.
+func (p Puzzle) Animate(r io.Reader, params map[string]int, frames anim.Sink) (part1, part2 Answer, err error) {
+	s, ok := p.New().(Animated)
+	if !ok {
+		return Answer{}, Answer{}, errors.New("no animation")
+	}
+	s.Animate(frames)
+	return p.solve(s, r, params)
+}
+
+func (p Puzzle) solve(s Solver, r io.Reader, params map[string]int) (part1, part2 Answer, err error) {
+	if err := SetParams(s, params); err != nil {
+		return Answer{}, Answer{}, err
+	}
+	if err := s.Parse(r); err != nil {
+		return Answer{}, Answer{}, fmt.Errorf("parse: %w", err)
+	}
+	if part1, err = s.Part1(); err != nil {
+		return Answer{}, Answer{}, fmt.Errorf("part 1: %w", err)
+	}
+	if part2, err = s.Part2(); err != nil {
+		return part1, Answer{}, fmt.Errorf("part 2: %w", err)
+	}
+	return part1, part2, nil
+}
+
+// SolvePart parses the input with a fresh solver, whose parameters are
+// overridden by params, and solves the given part, 1 or 2, alone.
+func (p Puzzle) SolvePart(r io.Reader, params map[string]int, part int) (Answer, error) {
+	if part != 1 && part != 2 {
+		return Answer{}, fmt.Errorf("no part %d", part)
+	}
+	s := p.New()
+	if err := SetParams(s, params); err != nil {
+		return Answer{}, err
+	}
+	if err := s.Parse(r); err != nil {
+		return Answer{}, fmt.Errorf("parse: %w", err)
+	}
+	solve := s.Part1
+	if part == 2 {
+		solve = s.Part2
+	}
+	a, err := solve()
+	if err != nil {
+		return Answer{}, fmt.Errorf("part %d: %w", part, err)
+	}
+	return a, nil
+}
+
+type key struct{ year, day int }
+
+var registry = map[key]Puzzle{}
+
+// Register adds the solver for the given year and day to the registry.
+// It is meant to be called from the init function of each day's package, and
+// panics if the day was already registered.
+func Register(year, day int, newSolver func() Solver) {
+	k := key{year, day}
+	if _, ok := registry[k]; ok {
+		panic(fmt.Sprintf("aoc: %d day %d registered twice", year, day))
+	}
+	registry[k] = Puzzle{Year: year, Day: day, New: newSolver}
+}
+
+// Lookup returns the solver registered for the given year and day.
+func Lookup(year, day int) (Puzzle, bool) {
+	p, ok := registry[key{year, day}]
+	return p, ok
+}
+
+// Days returns all registered solvers of the given year, ordered by day.
+func Days(year int) []Puzzle {
+	var ps []Puzzle
+	for k, p := range registry {
+		if k.year == year {
+			ps = append(ps, p)
+		}
+	}
+	slices.SortFunc(ps, func(a, b Puzzle) int {
+		return a.Day - b.Day
+	})
+	return ps
+}
+
+// Years returns all years that have at least one registered solver, in order.
+func Years() []int {
+	var ys []int
+	for k := range registry {
+		if !slices.Contains(ys, k.year) {
+			ys = append(ys, k.year)
+		}
+	}
+	slices.Sort(ys)
+	return ys
+}
@@ -0,0 +1,40 @@
+package aoc
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// ExamplesFile is the name of the file listing a puzzle's worked examples,
+// next to its code.
+const ExamplesFile = "examples.json"
+
+// Example is a worked example from a puzzle's statement: an input next to the
+// puzzle's code, the answers the statement gives for it, and the parameters
+// the statement sets for it.
+type Example struct {
+	Input string `json:"input"`
+	Expected
+	Params map[string]int `json:"params,omitempty"`
+}
+
+// ExamplesPath returns the location of the puzzle's examples, relative to the
+// repository root.
+func (p Puzzle) ExamplesPath() string {
+	return filepath.Join(p.Dir(), ExamplesFile)
+}
+
+// LoadExamples reads the list of examples at path.
+func LoadExamples(path string) ([]Example, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var es []Example
+	if err := json.Unmarshal(raw, &es); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return es, nil
+}
@@ -0,0 +1,55 @@
+package aoc
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Param is a named, integer parameter of a solver: a property of the puzzle
+// that the statement gives rather than the input holds, such as the size of a
+// room. Its default fits the real inputs, and examples override it.
+type Param struct {
+	Name  string
+	Usage string
+	// Value points into the solver, holding the default until overridden.
+	Value *int
+}
+
+// Parameterized is implemented by solvers that have parameters.
+type Parameterized interface {
+	Solver
+	Params() []Param
+}
+
+// SetParams overrides the parameters of s with the given values. Naming a
+// parameter that s does not have is an error.
+func SetParams(s Solver, values map[string]int) error {
+	if len(values) == 0 {
+		return nil
+	}
+	var ps []Param
+	if p, ok := s.(Parameterized); ok {
+		ps = p.Params()
+	}
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		i := slices.IndexFunc(ps, func(p Param) bool { return p.Name == name })
+		if i < 0 {
+			return fmt.Errorf("unknown parameter %q%s", name, known(ps))
+		}
+		*ps[i].Value = values[name]
+	}
+	return nil
+}
+
+func known(ps []Param) string {
+	if len(ps) == 0 {
+		return " (solver has no parameters)"
+	}
+	var names []string
+	for _, p := range ps {
+		names = append(names, p.Name)
+	}
+	return " (known: " + strings.Join(names, ", ") + ")"
+}
@@ -0,0 +1,100 @@
+// Package bench measures how long solvers take, and how much they allocate,
+// to parse their input and to solve each part, in the manner of
+// testing.Benchmark but with a fixed number of iterations, as some days take
+// minutes per run.
+package bench
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"time"
+
+	"github.com/liviro/aoc/internal/aoc"
+)
+
+// The phases of solving a puzzle, in the order they run.
+const (
+	Parse = "parse"
+	Part1 = "part1"
+	Part2 = "part2"
+)
+
+// Phase holds the measurements of one phase, averaged over its iterations.
+type Phase struct {
+	Name        string `json:"name"`
+	N           int    `json:"n"`
+	NsPerOp     int64  `json:"ns_per_op"`
+	AllocsPerOp int64  `json:"allocs_per_op"`
+	BytesPerOp  int64  `json:"bytes_per_op"`
+}
+
+// Result holds the measurements of every phase of a puzzle.
+type Result struct {
+	Year   int     `json:"year"`
+	Day    int     `json:"day"`
+	Phases []Phase `json:"phases"`
+}
+
+// Measure solves the puzzle n times over the given input, with a fresh solver
+// each time, measuring each phase separately.
+func Measure(p aoc.Puzzle, input []byte, params map[string]int, n int) (Result, error) {
+	if n < 1 {
+		return Result{}, fmt.Errorf("bench: %d iterations", n)
+	}
+	phases := []Phase{{Name: Parse}, {Name: Part1}, {Name: Part2}}
+	var total [3]counts
+	for i := 0; i < n; i++ {
+		s := p.New()
+		if err := aoc.SetParams(s, params); err != nil {
+			return Result{}, err
+		}
+		runs := []func() error{
+			func() error { return s.Parse(bytes.NewReader(input)) },
+			func() error { _, err := s.Part1(); return err },
+			func() error { _, err := s.Part2(); return err },
+		}
+		runtime.GC()
+		for j, run := range runs {
+			c, err := measure(run)
+			if err != nil {
+				return Result{}, fmt.Errorf("%d day %d: %s: %w", p.Year, p.Day, phases[j].Name, err)
+			}
+			total[j].add(c)
+		}
+	}
+	for j := range phases {
+		phases[j].N = n
+		phases[j].NsPerOp = total[j].ns / int64(n)
+		phases[j].AllocsPerOp = total[j].allocs / int64(n)
+		phases[j].BytesPerOp = total[j].bytes / int64(n)
+	}
+	return Result{Year: p.Year, Day: p.Day, Phases: phases}, nil
+}
+
+// counts are the measurements of a single run of a phase.
+type counts struct {
+	ns, allocs, bytes int64
+}
+
+func (c *counts) add(d counts) {
+	c.ns += d.ns
+	c.allocs += d.allocs
+	c.bytes += d.bytes
+}
+
+// measure runs f once, reading the allocator's statistics around it as
+// testing.B does.
+func measure(f func() error) (counts, error) {
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	err := f()
+	ns := time.Since(start).Nanoseconds()
+	runtime.ReadMemStats(&after)
+	return counts{
+		ns:     ns,
+		allocs: int64(after.Mallocs - before.Mallocs),
+		bytes:  int64(after.TotalAlloc - before.TotalAlloc),
+	}, err
+}
@@ -0,0 +1,82 @@
+package bench
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Noise is the least slowdown that can count as a regression, however large
+// it is relative to the previous time: below it, timings are mostly noise.
+const Noise = time.Millisecond
+
+// Regression is a phase that got slower, or allocates more, than it did in a
+// previous run.
+type Regression struct {
+	Year, Day int
+	Old, New  Phase
+}
+
+func (r Regression) String() string {
+	return fmt.Sprintf("%d day %d %s: %s -> %s, %d -> %d allocs/op",
+		r.Year, r.Day, r.New.Name,
+		time.Duration(r.Old.NsPerOp), time.Duration(r.New.NsPerOp),
+		r.Old.AllocsPerOp, r.New.AllocsPerOp)
+}
+
+// Compare returns the phases of cur that regressed from prev: those that take
+// more than the given fraction longer (and at least Noise longer), or make
+// more than that fraction more allocations. Phases missing from prev are not
+// compared.
+func Compare(prev, cur []Result, threshold float64) []Regression {
+	old := map[phaseKey]Phase{}
+	for _, r := range prev {
+		for _, ph := range r.Phases {
+			old[phaseKey{r.Year, r.Day, ph.Name}] = ph
+		}
+	}
+	var regs []Regression
+	for _, r := range cur {
+		for _, ph := range r.Phases {
+			o, ok := old[phaseKey{r.Year, r.Day, ph.Name}]
+			if !ok {
+				continue
+			}
+			slower := float64(ph.NsPerOp) > float64(o.NsPerOp)*(1+threshold) &&
+				time.Duration(ph.NsPerOp-o.NsPerOp) >= Noise
+			hungrier := float64(ph.AllocsPerOp) > float64(o.AllocsPerOp)*(1+threshold)
+			if slower || hungrier {
+				regs = append(regs, Regression{Year: r.Year, Day: r.Day, Old: o, New: ph})
+			}
+		}
+	}
+	return regs
+}
+
+type phaseKey struct {
+	year, day int
+	name      string
+}
+
+// Load reads results saved by Save.
+func Load(path string) ([]Result, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var rs []Result
+	if err := json.Unmarshal(raw, &rs); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return rs, nil
+}
+
+// Save writes results to path as JSON, to be compared against later.
+func Save(path string, rs []Result) error {
+	raw, err := json.MarshalIndent(rs, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(raw, '\n'), 0o644)
+}
@@ -0,0 +1,60 @@
+package bench
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func result(day int, phases ...Phase) Result {
+	return Result{Year: 2024, Day: day, Phases: phases}
+}
+
+func phase(name string, d time.Duration, allocs int64) Phase {
+	return Phase{Name: name, N: 1, NsPerOp: int64(d), AllocsPerOp: allocs}
+}
+
+func TestCompare(t *testing.T) {
+	prev := []Result{
+		result(1, phase(Parse, 10*time.Millisecond, 100), phase(Part1, 100*time.Microsecond, 0)),
+		result(2, phase(Parse, time.Second, 100)),
+	}
+	tests := []struct {
+		name string
+		cur  Result
+		want bool
+	}{
+		{"same", result(1, phase(Parse, 10*time.Millisecond, 100)), false},
+		{"within threshold", result(1, phase(Parse, 11*time.Millisecond, 110)), false},
+		{"slower", result(1, phase(Parse, 13*time.Millisecond, 100)), true},
+		{"slower but noise", result(1, phase(Part1, 500*time.Microsecond, 0)), false},
+		{"more allocs", result(1, phase(Parse, 10*time.Millisecond, 130)), true},
+		{"faster", result(2, phase(Parse, time.Millisecond, 10)), false},
+		{"new phase", result(2, phase(Part1, time.Hour, 1000)), false},
+		{"new day", result(3, phase(Parse, time.Hour, 1000)), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			regs := Compare(prev, []Result{tt.cur}, 0.2)
+			if got := len(regs) > 0; got != tt.want {
+				t.Errorf("Compare() = %v, want regression %t", regs, tt.want)
+			}
+		})
+	}
+}
+
+func TestSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bench.json")
+	rs := []Result{result(1, phase(Parse, time.Millisecond, 3), phase(Part1, time.Second, 0))}
+	if err := Save(path, rs); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, rs) {
+		t.Errorf("Load() = %v, want %v", got, rs)
+	}
+}
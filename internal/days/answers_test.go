@@ -0,0 +1,87 @@
+package days
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/liviro/aoc/internal/aoc"
+	"github.com/liviro/aoc/internal/site"
+)
+
+// root is the repository root, relative to this package.
+const root = "../.."
+
+// TestAnswers runs every registered solver against its input, and checks
+// its answers against the manifest. Puzzles without an input or a known
+// answer are skipped, as are slow ones unless AOC_SLOW is set.
+func TestAnswers(t *testing.T) {
+	answers, err := aoc.LoadAnswers(filepath.Join(root, aoc.AnswersPath))
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Skip("no answers manifest")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, y := range aoc.Years() {
+		for _, p := range aoc.Days(y) {
+			t.Run(strconv.Itoa(p.Year)+"/"+strconv.Itoa(p.Day), func(t *testing.T) {
+				t.Parallel()
+				testAnswers(t, p, answers)
+			})
+		}
+	}
+}
+
+func testAnswers(t *testing.T, p aoc.Puzzle, answers aoc.Answers) {
+	want, ok := answers.Get(p.Year, p.Day)
+	if !ok || want.Part1 == "" && want.Part2 == "" {
+		t.Skip("no known answers")
+	}
+	if want.Slow && os.Getenv("AOC_SLOW") == "" {
+		t.Skip("slow; set AOC_SLOW=1 to run")
+	}
+	f, err := os.Open(filepath.Join(root, p.InputPath()))
+	if errors.Is(err, fs.ErrNotExist) {
+		// Not committed next to the code: look in the cache of fetched inputs.
+		cache := site.Inputs{Dir: filepath.Join(root, site.DefaultInputsDir)}
+		f, err = os.Open(cache.Path(p.Year, p.Day))
+	}
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Skip("no input")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	s := p.New()
+	if err := s.Parse(f); err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	checkParts(t, s, want)
+}
+
+// checkParts solves the parts of a parsed puzzle that have an expected
+// answer, and checks them against it.
+func checkParts(t *testing.T, s aoc.Solver, want aoc.Expected) {
+	t.Helper()
+	parts := []func() (aoc.Answer, error){s.Part1, s.Part2}
+	for i, solve := range parts {
+		part := i + 1
+		if want.Part(part) == "" {
+			continue
+		}
+		got, err := solve()
+		if err != nil {
+			t.Errorf("part %d: %v", part, err)
+			continue
+		}
+		if aoc.Submission(got) != want.Part(part) {
+			t.Errorf("part %d = %q, want %q", part, aoc.Submission(got), want.Part(part))
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// Package days links in the solvers of every year and day, registering them
+// with the aoc registry.
+package days
+
+import (
+	_ "github.com/liviro/aoc/2021/day01"
+	_ "github.com/liviro/aoc/2021/day02"
+	_ "github.com/liviro/aoc/2021/day03"
+	_ "github.com/liviro/aoc/2021/day04"
+	_ "github.com/liviro/aoc/2021/day05"
+	_ "github.com/liviro/aoc/2021/day06"
+	_ "github.com/liviro/aoc/2021/day07"
+	_ "github.com/liviro/aoc/2021/day08"
+	_ "github.com/liviro/aoc/2021/day09"
+	_ "github.com/liviro/aoc/2021/day10"
+	_ "github.com/liviro/aoc/2021/day11"
+	_ "github.com/liviro/aoc/2021/day12"
+	_ "github.com/liviro/aoc/2021/day13"
+	_ "github.com/liviro/aoc/2021/day14"
+	_ "github.com/liviro/aoc/2021/day15"
+	_ "github.com/liviro/aoc/2021/day16"
+	_ "github.com/liviro/aoc/2021/day17"
+	_ "github.com/liviro/aoc/2021/day18"
+
+	_ "github.com/liviro/aoc/2022/day01"
+	_ "github.com/liviro/aoc/2022/day02"
+	_ "github.com/liviro/aoc/2022/day03"
+	_ "github.com/liviro/aoc/2022/day04"
+	_ "github.com/liviro/aoc/2022/day05"
+	_ "github.com/liviro/aoc/2022/day06"
+
+	_ "github.com/liviro/aoc/2024/day01"
+	_ "github.com/liviro/aoc/2024/day02"
+	_ "github.com/liviro/aoc/2024/day03"
+	_ "github.com/liviro/aoc/2024/day04"
+	_ "github.com/liviro/aoc/2024/day05"
+	_ "github.com/liviro/aoc/2024/day06"
+	_ "github.com/liviro/aoc/2024/day07"
+	_ "github.com/liviro/aoc/2024/day08"
+	_ "github.com/liviro/aoc/2024/day09"
+	_ "github.com/liviro/aoc/2024/day10"
+	_ "github.com/liviro/aoc/2024/day11"
+	_ "github.com/liviro/aoc/2024/day12"
+	_ "github.com/liviro/aoc/2024/day13"
+	_ "github.com/liviro/aoc/2024/day14"
+	_ "github.com/liviro/aoc/2024/day15"
+	_ "github.com/liviro/aoc/2024/day16"
+	_ "github.com/liviro/aoc/2024/day17"
+	_ "github.com/liviro/aoc/2024/day18"
+	_ "github.com/liviro/aoc/2024/day19"
+	_ "github.com/liviro/aoc/2024/day20"
+	_ "github.com/liviro/aoc/2024/day21"
+	_ "github.com/liviro/aoc/2024/day22"
+	_ "github.com/liviro/aoc/2024/day23"
+	_ "github.com/liviro/aoc/2024/day24"
+	_ "github.com/liviro/aoc/2024/day25"
+
+	_ "github.com/liviro/aoc/2025/day01"
+	_ "github.com/liviro/aoc/2025/day02"
+	_ "github.com/liviro/aoc/2025/day03"
+	_ "github.com/liviro/aoc/2025/day04"
+	_ "github.com/liviro/aoc/2025/day05"
+	_ "github.com/liviro/aoc/2025/day06"
+	_ "github.com/liviro/aoc/2025/day07"
+	_ "github.com/liviro/aoc/2025/day08"
+)
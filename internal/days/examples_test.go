@@ -0,0 +1,53 @@
+package days
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/liviro/aoc/internal/aoc"
+)
+
+// TestExamples runs every registered solver against the worked examples
+// listed next to it, with the examples' parameters.
+func TestExamples(t *testing.T) {
+	for _, y := range aoc.Years() {
+		for _, p := range aoc.Days(y) {
+			t.Run(strconv.Itoa(p.Year)+"/"+strconv.Itoa(p.Day), func(t *testing.T) {
+				t.Parallel()
+				es, err := aoc.LoadExamples(filepath.Join(root, p.ExamplesPath()))
+				if errors.Is(err, fs.ErrNotExist) {
+					t.Skip("no examples")
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, e := range es {
+					t.Run(e.Input, func(t *testing.T) {
+						testExample(t, p, e)
+					})
+				}
+			})
+		}
+	}
+}
+
+func testExample(t *testing.T, p aoc.Puzzle, e aoc.Example) {
+	f, err := os.Open(filepath.Join(root, p.Dir(), e.Input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	s := p.New()
+	if err := aoc.SetParams(s, e.Params); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Parse(f); err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	checkParts(t, s, e.Expected)
+}
@@ -0,0 +1,264 @@
+package grid
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+
+	"github.com/liviro/aoc/internal/parse"
+)
+
+// Grid is a dense, rectangular grid of cells of type T.
+type Grid[T any] struct {
+	W, H  int
+	cells []T
+}
+
+// New returns a w by h grid of zero cells.
+func New[T any](w, h int) *Grid[T] {
+	return &Grid[T]{W: w, H: h, cells: make([]T, w*h)}
+}
+
+// Read reads a grid from r, one row per line, converting each character with
+// f.
+func Read[T any](r io.Reader, f func(rune) (T, error)) (*Grid[T], error) {
+	ls, err := parse.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(ls, f)
+}
+
+// Parse builds a grid from the given lines, one row per line, converting each
+// character with f. Errors returned by f are reported at the character's
+// position.
+func Parse[T any](lines []parse.Span, f func(rune) (T, error)) (*Grid[T], error) {
+	rows, err := parse.Grid(lines)
+	if err != nil {
+		return nil, err
+	}
+	g := New[T](len(rows[0]), len(rows))
+	for y, l := range lines {
+		x := 0
+		for i, c := range l.Text {
+			v, err := f(c)
+			if err != nil {
+				return nil, &parse.Error{Pos: l.At(i), Err: err}
+			}
+			g.cells[y*g.W+x] = v
+			x++
+		}
+	}
+	return g, nil
+}
+
+// Runes is the identity conversion for Read and Parse, keeping the grid's
+// characters as they are.
+func Runes(r rune) (rune, error) {
+	return r, nil
+}
+
+// RunesOf returns a conversion for Read and Parse that keeps the characters in
+// set as they are, and rejects any other character.
+func RunesOf(set string) func(rune) (rune, error) {
+	return func(r rune) (rune, error) {
+		if !strings.ContainsRune(set, r) {
+			return r, fmt.Errorf("unexpected %q", r)
+		}
+		return r, nil
+	}
+}
+
+// Digit converts a decimal digit character into its value, for Read and
+// Parse.
+func Digit(r rune) (int, error) {
+	if r < '0' || r > '9' {
+		return 0, fmt.Errorf("expected digit, got %q", r)
+	}
+	return int(r - '0'), nil
+}
+
+// OneOf returns a conversion for Read and Parse that maps the characters in m
+// to their values, and rejects any other character.
+func OneOf[T any](m map[rune]T) func(rune) (T, error) {
+	return func(r rune) (T, error) {
+		v, ok := m[r]
+		if !ok {
+			return v, fmt.Errorf("unexpected %q", r)
+		}
+		return v, nil
+	}
+}
+
+// Map returns a grid of the same size as g, holding f applied to each cell.
+func Map[T, U any](g *Grid[T], f func(T) U) *Grid[U] {
+	m := New[U](g.W, g.H)
+	for i, v := range g.cells {
+		m.cells[i] = f(v)
+	}
+	return m
+}
+
+// In returns whether p is within the grid.
+func (g *Grid[T]) In(p Point) bool {
+	return p.X >= 0 && p.Y >= 0 && p.X < g.W && p.Y < g.H
+}
+
+// At returns the cell at p, which must be within the grid.
+func (g *Grid[T]) At(p Point) T {
+	g.check(p)
+	return g.cells[p.Y*g.W+p.X]
+}
+
+// Get returns the cell at p, and whether p is within the grid. Outside of the
+// grid, the zero T is returned.
+func (g *Grid[T]) Get(p Point) (T, bool) {
+	if !g.In(p) {
+		var zero T
+		return zero, false
+	}
+	return g.cells[p.Y*g.W+p.X], true
+}
+
+// Set sets the cell at p, which must be within the grid.
+func (g *Grid[T]) Set(p Point, v T) {
+	g.check(p)
+	g.cells[p.Y*g.W+p.X] = v
+}
+
+func (g *Grid[T]) check(p Point) {
+	if !g.In(p) {
+		panic(fmt.Sprintf("grid: %v outside of %dx%d grid", p, g.W, g.H))
+	}
+}
+
+// All yields every point of the grid with its cell, row by row.
+func (g *Grid[T]) All() iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for i, v := range g.cells {
+			if !yield(Point{i % g.W, i / g.W}, v) {
+				return
+			}
+		}
+	}
+}
+
+// Find returns the first point, row by row, whose cell satisfies f.
+func (g *Grid[T]) Find(f func(T) bool) (Point, bool) {
+	for p, v := range g.All() {
+		if f(v) {
+			return p, true
+		}
+	}
+	return Point{}, false
+}
+
+// Neighbors4 returns the points orthogonally adjacent to p that are within the
+// grid.
+func (g *Grid[T]) Neighbors4(p Point) []Point {
+	return g.within(p.Neighbors4())
+}
+
+// Neighbors8 returns the points orthogonally or diagonally adjacent to p that
+// are within the grid.
+func (g *Grid[T]) Neighbors8(p Point) []Point {
+	return g.within(p.Neighbors8())
+}
+
+func (g *Grid[T]) within(ps []Point) []Point {
+	in := ps[:0]
+	for _, p := range ps {
+		if g.In(p) {
+			in = append(in, p)
+		}
+	}
+	return in
+}
+
+// Clone returns a copy of the grid. Cells are copied as by assignment.
+func (g *Grid[T]) Clone() *Grid[T] {
+	c := New[T](g.W, g.H)
+	copy(c.cells, g.cells)
+	return c
+}
+
+// RotateRight returns a copy of the grid turned a quarter turn clockwise.
+func (g *Grid[T]) RotateRight() *Grid[T] {
+	r := New[T](g.H, g.W)
+	for p, v := range g.All() {
+		r.Set(Point{g.H - 1 - p.Y, p.X}, v)
+	}
+	return r
+}
+
+// RotateLeft returns a copy of the grid turned a quarter turn
+// counterclockwise.
+func (g *Grid[T]) RotateLeft() *Grid[T] {
+	r := New[T](g.H, g.W)
+	for p, v := range g.All() {
+		r.Set(Point{p.Y, g.W - 1 - p.X}, v)
+	}
+	return r
+}
+
+// Sparse returns a sparse grid holding the cells of g that satisfy keep, with
+// the bounds of g.
+func (g *Grid[T]) Sparse(keep func(T) bool) *Sparse[T] {
+	s := NewSparse[T]()
+	s.min, s.max = Point{0, 0}, Point{g.W - 1, g.H - 1}
+	s.bounded = true
+	for p, v := range g.All() {
+		if keep(v) {
+			s.cells[p] = v
+		}
+	}
+	return s
+}
+
+// Render draws the grid, one line per row, drawing each cell with f.
+func (g *Grid[T]) Render(f func(Point, T) rune) string {
+	var b strings.Builder
+	for p, v := range g.All() {
+		b.WriteRune(f(p, v))
+		if p.X == g.W-1 {
+			b.WriteByte('\n')
+		}
+	}
+	return b.String()
+}
+
+// String draws the grid with each cell drawn by cellRune.
+func (g *Grid[T]) String() string {
+	return g.Render(func(_ Point, v T) rune {
+		return cellRune(v)
+	})
+}
+
+// cellRune draws a cell of a grid: runes and bytes as themselves, booleans as
+// '#' or '.', empty structs (as used by sets) as '#', digits as themselves and
+// anything else as '?'.
+func cellRune(v any) rune {
+	switch v := v.(type) {
+	case rune:
+		return v
+	case byte:
+		return rune(v)
+	case bool:
+		if v {
+			return '#'
+		}
+		return '.'
+	case struct{}:
+		return '#'
+	case int:
+		if v >= 0 && v <= 9 {
+			return rune('0' + v)
+		}
+	case fmt.Stringer:
+		if s := []rune(v.String()); len(s) == 1 {
+			return s[0]
+		}
+	}
+	return '?'
+}
@@ -0,0 +1,176 @@
+package grid
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/liviro/aoc/internal/parse"
+)
+
+func read(t *testing.T, s string) *Grid[rune] {
+	t.Helper()
+	g, err := Read(strings.NewReader(s), Runes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestRead(t *testing.T) {
+	g := read(t, "ab.\n#cd\n")
+	if g.W != 3 || g.H != 2 {
+		t.Fatalf("got a %dx%d grid, want 3x2", g.W, g.H)
+	}
+	if got := g.String(); got != "ab.\n#cd\n" {
+		t.Errorf("String() = %q", got)
+	}
+	_, err := Read(strings.NewReader("..\n.x\n"), RunesOf(".#"))
+	var pe *parse.Error
+	if !errors.As(err, &pe) || pe.Pos.Line != 2 || pe.Pos.Col != 2 {
+		t.Errorf("reading an unknown rune: %v, want an error at 2:2", err)
+	}
+	if _, err := Read(strings.NewReader("12\n3\n"), Digit); err == nil {
+		t.Error("read a ragged grid")
+	}
+}
+
+func TestBounds(t *testing.T) {
+	g := read(t, "ab\ncd\ne.\n")
+	for _, tc := range []struct {
+		p  Point
+		v  rune
+		in bool
+	}{
+		{Point{0, 0}, 'a', true},
+		{Point{1, 0}, 'b', true},
+		{Point{0, 2}, 'e', true},
+		{Point{1, 2}, '.', true},
+		{Point{-1, 0}, 0, false},
+		{Point{0, -1}, 0, false},
+		{Point{2, 0}, 0, false},
+		{Point{0, 3}, 0, false},
+		// Would wrap around into the next row with a flat index.
+		{Point{2, 1}, 0, false},
+	} {
+		if got := g.In(tc.p); got != tc.in {
+			t.Errorf("In(%v) = %v, want %v", tc.p, got, tc.in)
+		}
+		if v, ok := g.Get(tc.p); v != tc.v || ok != tc.in {
+			t.Errorf("Get(%v) = %q, %v, want %q, %v", tc.p, v, ok, tc.v, tc.in)
+		}
+		func() {
+			defer func() {
+				if r := recover(); (r != nil) == tc.in {
+					t.Errorf("At(%v) panicked: %v, want a panic: %v", tc.p, r, !tc.in)
+				}
+			}()
+			if v := g.At(tc.p); v != tc.v {
+				t.Errorf("At(%v) = %q, want %q", tc.p, v, tc.v)
+			}
+		}()
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	g := New[int](3, 3)
+	if got := g.Neighbors4(Point{0, 0}); !slices.Equal(got, []Point{{1, 0}, {0, 1}}) {
+		t.Errorf("Neighbors4 of a corner = %v", got)
+	}
+	if got := g.Neighbors8(Point{1, 1}); len(got) != 8 {
+		t.Errorf("Neighbors8 of the center = %v", got)
+	}
+	if got := g.Neighbors8(Point{2, 0}); !slices.Equal(got, []Point{{2, 1}, {1, 1}, {1, 0}}) {
+		t.Errorf("Neighbors8 of a corner = %v", got)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	g := read(t, "abc\ndef\n")
+	if got := g.RotateRight().String(); got != "da\neb\nfc\n" {
+		t.Errorf("RotateRight() = %q", got)
+	}
+	if got := g.RotateLeft().String(); got != "cf\nbe\nad\n" {
+		t.Errorf("RotateLeft() = %q", got)
+	}
+	if got := g.RotateRight().RotateLeft().String(); got != g.String() {
+		t.Errorf("RotateRight().RotateLeft() = %q", got)
+	}
+	c := g.Clone()
+	c.Set(Point{0, 0}, 'z')
+	if g.At(Point{0, 0}) != 'a' {
+		t.Error("setting a clone changed the grid")
+	}
+}
+
+func TestDirs(t *testing.T) {
+	for i, d := range Dirs4 {
+		if got, want := d.TurnRight(), Dirs4[(i+1)%4]; got != want {
+			t.Errorf("%v.TurnRight() = %v, want %v", d, got, want)
+		}
+		if got, want := d.TurnLeft(), Dirs4[(i+3)%4]; got != want {
+			t.Errorf("%v.TurnLeft() = %v, want %v", d, got, want)
+		}
+		if got, want := d.Reverse(), Dirs4[(i+2)%4]; got != want {
+			t.Errorf("%v.Reverse() = %v, want %v", d, got, want)
+		}
+		if Dirs8[2*i] != d {
+			t.Errorf("Dirs8[%d] = %v, want %v", 2*i, Dirs8[2*i], d)
+		}
+	}
+	// The diagonals sit between the orthogonal directions around them.
+	for i := 1; i < 8; i += 2 {
+		if got, want := Dirs8[i], Dirs8[i-1].Add(Dirs8[(i+1)%8]); got != want {
+			t.Errorf("Dirs8[%d] = %v, want %v", i, got, want)
+		}
+	}
+	if Up.Add(Right).Manhattan(Point{}) != 2 || Up.Mul(3) != (Point{0, -3}) {
+		t.Error("arithmetic on directions is off")
+	}
+}
+
+func TestSparse(t *testing.T) {
+	s := NewSparse[bool]()
+	if s.String() != "" {
+		t.Errorf("an empty sparse grid draws as %q", s.String())
+	}
+	s.Set(Point{2, -1}, true)
+	s.Set(Point{-1, 1}, false)
+	if lo, hi := s.Bounds(); lo != (Point{-1, -1}) || hi != (Point{2, 1}) {
+		t.Errorf("Bounds() = %v, %v", lo, hi)
+	}
+	// Cells set to false draw as the unset ones do, unlike in Render.
+	if got, want := s.String(), "...#\n....\n....\n"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	got := s.Render(func(_ Point, _ bool, ok bool) rune {
+		if ok {
+			return 'o'
+		}
+		return ' '
+	})
+	if want := "   o\n    \no   \n"; got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+	if v, ok := s.Get(Point{-1, 1}); v || !ok || !s.Has(Point{-1, 1}) {
+		t.Errorf("Get of a cell set to false = %v, %v", v, ok)
+	}
+	if _, ok := s.Get(Point{0, 0}); ok || s.Has(Point{0, 0}) {
+		t.Error("an unset cell is there")
+	}
+	c := s.Clone()
+	s.Delete(Point{2, -1})
+	if s.Len() != 1 || c.Len() != 2 {
+		t.Errorf("after deleting from the original, %d and %d cells, want 1 and 2", s.Len(), c.Len())
+	}
+	if lo, hi := s.Bounds(); lo != (Point{-1, -1}) || hi != (Point{2, 1}) {
+		t.Errorf("deleting changed the bounds to %v, %v", lo, hi)
+	}
+
+	g := read(t, "#.\n.#\n")
+	sg := g.Sparse(func(r rune) bool { return r == '#' })
+	if sg.Len() != 2 || sg.String() != "#.\n.#\n" {
+		t.Errorf("Sparse() = %d cells drawn as %q", sg.Len(), sg.String())
+	}
+}
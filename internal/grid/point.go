@@ -0,0 +1,93 @@
+// Package grid provides the two-dimensional grids that many puzzles are played
+// on: points and directions, a dense rectangular grid parsed from the input,
+// and a sparse map-backed grid for unbounded or mostly empty areas.
+package grid
+
+import "fmt"
+
+// Point is a position on a grid, or a direction between two positions. X
+// grows to the right and Y grows downwards, as rows are read from the input.
+type Point struct {
+	X, Y int
+}
+
+// The four orthogonal directions.
+var (
+	Up    = Point{0, -1}
+	Right = Point{1, 0}
+	Down  = Point{0, 1}
+	Left  = Point{-1, 0}
+)
+
+// Dirs4 holds the orthogonal directions, clockwise starting from Up.
+var Dirs4 = []Point{Up, Right, Down, Left}
+
+// Dirs8 holds the orthogonal and diagonal directions, clockwise starting from
+// Up.
+var Dirs8 = []Point{Up, {1, -1}, Right, {1, 1}, Down, {-1, 1}, Left, {-1, -1}}
+
+func (p Point) String() string {
+	return fmt.Sprintf("(%d, %d)", p.X, p.Y)
+}
+
+// Add returns p moved by q.
+func (p Point) Add(q Point) Point {
+	return Point{p.X + q.X, p.Y + q.Y}
+}
+
+// Sub returns the direction from q to p.
+func (p Point) Sub(q Point) Point {
+	return Point{p.X - q.X, p.Y - q.Y}
+}
+
+// Mul returns p scaled by k.
+func (p Point) Mul(k int) Point {
+	return Point{p.X * k, p.Y * k}
+}
+
+// Manhattan returns the taxicab distance between p and q.
+func (p Point) Manhattan(q Point) int {
+	return abs(p.X-q.X) + abs(p.Y-q.Y)
+}
+
+// TurnRight returns the direction d rotated a quarter turn clockwise.
+func (d Point) TurnRight() Point {
+	return Point{-d.Y, d.X}
+}
+
+// TurnLeft returns the direction d rotated a quarter turn counterclockwise.
+func (d Point) TurnLeft() Point {
+	return Point{d.Y, -d.X}
+}
+
+// Reverse returns the direction opposite to d.
+func (d Point) Reverse() Point {
+	return Point{-d.X, -d.Y}
+}
+
+// Neighbors4 returns the points orthogonally adjacent to p, clockwise starting
+// from the one above.
+func (p Point) Neighbors4() []Point {
+	return p.around(Dirs4)
+}
+
+// Neighbors8 returns the points orthogonally or diagonally adjacent to p,
+// clockwise starting from the one above.
+func (p Point) Neighbors8() []Point {
+	return p.around(Dirs8)
+}
+
+func (p Point) around(dirs []Point) []Point {
+	ns := make([]Point, len(dirs))
+	for i, d := range dirs {
+		ns[i] = p.Add(d)
+	}
+	return ns
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,99 @@
+package grid
+
+import (
+	"iter"
+	"maps"
+	"strings"
+)
+
+// Sparse is a map-backed grid, holding cells only at the points that were set.
+// Its bounds grow to include every point that is set.
+type Sparse[T any] struct {
+	cells    map[Point]T
+	min, max Point
+	bounded  bool
+}
+
+// NewSparse returns an empty sparse grid.
+func NewSparse[T any]() *Sparse[T] {
+	return &Sparse[T]{cells: map[Point]T{}}
+}
+
+// Len returns the number of cells that are set.
+func (s *Sparse[T]) Len() int {
+	return len(s.cells)
+}
+
+// Has returns whether the cell at p is set.
+func (s *Sparse[T]) Has(p Point) bool {
+	_, ok := s.cells[p]
+	return ok
+}
+
+// Get returns the cell at p, and whether it is set.
+func (s *Sparse[T]) Get(p Point) (T, bool) {
+	v, ok := s.cells[p]
+	return v, ok
+}
+
+// Set sets the cell at p, growing the bounds if needed.
+func (s *Sparse[T]) Set(p Point, v T) {
+	s.cells[p] = v
+	if !s.bounded {
+		s.min, s.max = p, p
+		s.bounded = true
+		return
+	}
+	s.min = Point{min(s.min.X, p.X), min(s.min.Y, p.Y)}
+	s.max = Point{max(s.max.X, p.X), max(s.max.Y, p.Y)}
+}
+
+// Delete unsets the cell at p. The bounds are left as they are.
+func (s *Sparse[T]) Delete(p Point) {
+	delete(s.cells, p)
+}
+
+// Bounds returns the top-left and bottom-right corners of the smallest
+// rectangle holding every point that was set.
+func (s *Sparse[T]) Bounds() (lo, hi Point) {
+	return s.min, s.max
+}
+
+// All yields every set point with its cell, in no particular order.
+func (s *Sparse[T]) All() iter.Seq2[Point, T] {
+	return maps.All(s.cells)
+}
+
+// Clone returns a copy of the sparse grid. Cells are copied as by assignment.
+func (s *Sparse[T]) Clone() *Sparse[T] {
+	return &Sparse[T]{cells: maps.Clone(s.cells), min: s.min, max: s.max, bounded: s.bounded}
+}
+
+// Render draws the grid within its bounds, one line per row, drawing each
+// point with f. For unset points, f is passed the zero T and false.
+func (s *Sparse[T]) Render(f func(p Point, v T, ok bool) rune) string {
+	if !s.bounded {
+		return ""
+	}
+	var b strings.Builder
+	for y := s.min.Y; y <= s.max.Y; y++ {
+		for x := s.min.X; x <= s.max.X; x++ {
+			p := Point{x, y}
+			v, ok := s.cells[p]
+			b.WriteRune(f(p, v, ok))
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// String draws the grid within its bounds, with set cells drawn like those of
+// a dense grid, and unset ones as '.'.
+func (s *Sparse[T]) String() string {
+	return s.Render(func(_ Point, v T, ok bool) rune {
+		if !ok {
+			return '.'
+		}
+		return cellRune(v)
+	})
+}
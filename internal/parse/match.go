@@ -0,0 +1,107 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Match matches the whole span against pattern, storing the values of its
+// verbs into args in order. Text in the pattern must appear literally in the
+// span. The verbs are:
+//
+//	%d  an optionally signed decimal integer, stored into an *int
+//	%s  a non-empty word, stored into a *string; it ends at whitespace or at
+//	    the literal text that follows the verb in the pattern
+//	%%  a literal percent sign
+//
+// Unlike fmt.Sscanf, a mismatch is reported with the column where the span
+// stopped following the pattern.
+func Match(s Span, pattern string, args ...any) error {
+	t := s.Text
+	i, n := 0, 0
+	for p := 0; p < len(pattern); p++ {
+		if pattern[p] != '%' || (p+1 < len(pattern) && pattern[p+1] == '%') {
+			if pattern[p] == '%' {
+				p++
+			}
+			if i >= len(t) || t[i] != pattern[p] {
+				return s.errorAt(i, "expected %q", literal(pattern[p:]))
+			}
+			i++
+			continue
+		}
+		if p+1 >= len(pattern) {
+			return fmt.Errorf("parse: pattern %q ends with %%", pattern)
+		}
+		p++
+		if n >= len(args) {
+			return fmt.Errorf("parse: pattern %q has more verbs than arguments", pattern)
+		}
+		arg := args[n]
+		n++
+		switch pattern[p] {
+		case 'd':
+			ptr, ok := arg.(*int)
+			if !ok {
+				return fmt.Errorf("parse: %%d wants *int, got %T", arg)
+			}
+			j := i
+			if j < len(t) && (t[j] == '-' || t[j] == '+') {
+				j++
+			}
+			for j < len(t) && isDigit(t[j]) {
+				j++
+			}
+			v, err := s.Slice(i, j).Int()
+			if err != nil {
+				return s.errorAt(i, "expected integer")
+			}
+			*ptr = v
+			i = j
+		case 's':
+			ptr, ok := arg.(*string)
+			if !ok {
+				return fmt.Errorf("parse: %%s wants *string, got %T", arg)
+			}
+			var stop byte
+			if p+1 < len(pattern) && pattern[p+1] != '%' {
+				stop = pattern[p+1]
+			}
+			j := i
+			for j < len(t) && t[j] != stop && !unicode.IsSpace(rune(t[j])) {
+				j++
+			}
+			if j == i {
+				return s.errorAt(i, "expected word")
+			}
+			*ptr = t[i:j]
+			i = j
+		default:
+			return fmt.Errorf("parse: unknown verb %%%c in pattern %q", pattern[p], pattern)
+		}
+	}
+	if n < len(args) {
+		return fmt.Errorf("parse: pattern %q has fewer verbs than arguments", pattern)
+	}
+	if i < len(t) {
+		return s.errorAt(i, "unexpected %q", t[i:])
+	}
+	return nil
+}
+
+// literal returns the literal text at the start of the given pattern, up to
+// its next verb.
+func literal(pattern string) string {
+	var b strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i] == '%' {
+			if i+1 >= len(pattern) || pattern[i+1] != '%' {
+				break
+			}
+			i++
+		}
+		b.WriteByte(pattern[i])
+	}
+	return b.String()
+}
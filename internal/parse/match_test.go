@@ -0,0 +1,98 @@
+package parse
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	for _, tc := range []struct {
+		text, pattern string
+		ints          []int
+		strs          []string
+	}{
+		{"p=0,4 v=3,-3", "p=%d,%d v=%d,%d", []int{0, 4, 3, -3}, nil},
+		{"+12", "%d", []int{12}, nil},
+		{"fold along x=655", "fold along %s=%d", []int{655}, []string{"x"}},
+		{"move 3 from 1 to 2", "move %d from %d to %d", []int{3, 1, 2}, nil},
+		{"x00 AND y00 -> z00", "%s %s %s -> %s", nil, []string{"x00", "AND", "y00", "z00"}},
+		{"50% off", "%d%% off", []int{50}, nil},
+		{"Button A: X+94, Y+34", "Button %s X+%d, Y+%d", []int{94, 34}, []string{"A:"}},
+	} {
+		var args []any
+		ints := make([]int, len(tc.ints))
+		strs := make([]string, len(tc.strs))
+		// The verbs of these patterns come with the words first.
+		for i := range strs {
+			args = append(args, &strs[i])
+		}
+		for i := range ints {
+			args = append(args, &ints[i])
+		}
+		if err := Match(Span{Text: tc.text}, tc.pattern, args...); err != nil {
+			t.Errorf("Match(%q, %q): %v", tc.text, tc.pattern, err)
+			continue
+		}
+		for i := range ints {
+			if ints[i] != tc.ints[i] {
+				t.Errorf("Match(%q, %q): int %d = %d, want %d", tc.text, tc.pattern, i, ints[i], tc.ints[i])
+			}
+		}
+		for i := range strs {
+			if strs[i] != tc.strs[i] {
+				t.Errorf("Match(%q, %q): word %d = %q, want %q", tc.text, tc.pattern, i, strs[i], tc.strs[i])
+			}
+		}
+	}
+}
+
+func TestMatchErrors(t *testing.T) {
+	line := Pos{File: "in.txt", Line: 3, Col: 1}
+	var a, b int
+	var w string
+	for _, tc := range []struct {
+		text, pattern string
+		args          []any
+		// col is the column of the error, or 0 for errors in the pattern
+		// rather than the text.
+		col  int
+		want string
+	}{
+		{"p=1,x", "p=%d,%d", []any{&a, &b}, 5, "in.txt:3:5: expected integer"},
+		{"p=1;2", "p=%d,%d", []any{&a, &b}, 4, `in.txt:3:4: expected ","`},
+		{"p=1,2 extra", "p=%d,%d", []any{&a, &b}, 6, `in.txt:3:6: unexpected " extra"`},
+		{"p=", "p=%d", []any{&a}, 3, "in.txt:3:3: expected integer"},
+		{"fold along =3", "fold along %s=%d", []any{&w, &a}, 12, "in.txt:3:12: expected word"},
+		{"q=1", "p=%d", []any{&a}, 1, `in.txt:3:1: expected "p="`},
+		{"1 ", "%d %", []any{&a}, 0, `parse: pattern "%d %" ends with %`},
+		{"1", "%x", []any{&a}, 0, `parse: unknown verb %x in pattern "%x"`},
+	} {
+		err := Match(Span{Text: tc.text, Pos: line}, tc.pattern, tc.args...)
+		if err == nil || err.Error() != tc.want {
+			t.Errorf("Match(%q, %q) = %v, want %s", tc.text, tc.pattern, err, tc.want)
+			continue
+		}
+		var pe *Error
+		if isPos := errors.As(err, &pe); isPos != (tc.col > 0) || (isPos && pe.Pos.Col != tc.col) {
+			t.Errorf("Match(%q, %q): error %#v, want it at column %d", tc.text, tc.pattern, err, tc.col)
+		}
+	}
+}
+
+func TestMatchArgs(t *testing.T) {
+	var n int
+	var s string
+	for _, tc := range []struct {
+		pattern string
+		args    []any
+	}{
+		{"%d %d", []any{&n}},
+		{"%d", []any{&n, &n}},
+		{"%d", []any{&s}},
+		{"%s", []any{&n}},
+	} {
+		if err := Match(Span{Text: "1 2"}, tc.pattern, tc.args...); err == nil {
+			t.Errorf("Match(%q) with %d args succeeded", tc.pattern, len(tc.args))
+		}
+	}
+}
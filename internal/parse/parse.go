@@ -0,0 +1,231 @@
+// Package parse reads puzzle inputs. Every piece of text it hands out remembers
+// where in the input it came from, so that a malformed input is reported as
+// file:line:column instead of causing a panic or a silently wrong answer.
+package parse
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Pos is a position in an input. Line and Col are 1-based; Col counts bytes.
+type Pos struct {
+	File      string
+	Line, Col int
+}
+
+func (p Pos) String() string {
+	s := fmt.Sprintf("%d:%d", p.Line, p.Col)
+	if p.File != "" {
+		s = p.File + ":" + s
+	}
+	return s
+}
+
+// Error is an error at a given position of the input.
+type Error struct {
+	Pos Pos
+	Err error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %v", e.Pos, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Span is a piece of a single line of input, along with the position of its
+// first byte.
+type Span struct {
+	Text string
+	Pos  Pos
+}
+
+// At returns the position of the i-th byte of the span.
+func (s Span) At(i int) Pos {
+	p := s.Pos
+	p.Col += i
+	return p
+}
+
+// Errorf returns an error positioned at the start of the span.
+func (s Span) Errorf(format string, args ...any) error {
+	return s.errorAt(0, format, args...)
+}
+
+func (s Span) errorAt(i int, format string, args ...any) error {
+	return &Error{Pos: s.At(i), Err: fmt.Errorf(format, args...)}
+}
+
+// Slice returns the part of the span between byte offsets i and j.
+func (s Span) Slice(i, j int) Span {
+	return Span{Text: s.Text[i:j], Pos: s.At(i)}
+}
+
+// TrimPrefix returns the span without the given prefix, which it must start
+// with.
+func (s Span) TrimPrefix(prefix string) (Span, error) {
+	if !strings.HasPrefix(s.Text, prefix) {
+		return s, s.Errorf("expected %q", prefix)
+	}
+	return s.Slice(len(prefix), len(s.Text)), nil
+}
+
+// Int parses the whole span as a decimal integer.
+func (s Span) Int() (int, error) {
+	n, err := strconv.Atoi(s.Text)
+	if err != nil {
+		return 0, s.Errorf("invalid integer %q", s.Text)
+	}
+	return n, nil
+}
+
+// Lines reads all lines of r. Trailing blank lines are dropped. If r has a
+// Name method, as *os.File does, its result is used as the file name in
+// positions.
+func Lines(r io.Reader) ([]Span, error) {
+	var name string
+	if n, ok := r.(interface{ Name() string }); ok {
+		name = n.Name()
+	}
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, 1<<24)
+	var ls []Span
+	for i := 1; sc.Scan(); i++ {
+		ls = append(ls, Span{
+			Text: strings.TrimSuffix(sc.Text(), "\r"),
+			Pos:  Pos{File: name, Line: i, Col: 1},
+		})
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	for len(ls) > 0 && strings.TrimSpace(ls[len(ls)-1].Text) == "" {
+		ls = ls[:len(ls)-1]
+	}
+	return ls, nil
+}
+
+// Sections reads r and splits its lines into groups separated by blank lines.
+func Sections(r io.Reader) ([][]Span, error) {
+	ls, err := Lines(r)
+	if err != nil {
+		return nil, err
+	}
+	var ss [][]Span
+	var cur []Span
+	for _, l := range ls {
+		if strings.TrimSpace(l.Text) == "" {
+			if cur != nil {
+				ss = append(ss, cur)
+				cur = nil
+			}
+			continue
+		}
+		cur = append(cur, l)
+	}
+	if cur != nil {
+		ss = append(ss, cur)
+	}
+	return ss, nil
+}
+
+// Fields splits the span around runs of whitespace.
+func Fields(s Span) []Span {
+	var fs []Span
+	start := -1
+	for i, c := range s.Text {
+		switch {
+		case unicode.IsSpace(c) && start >= 0:
+			fs = append(fs, s.Slice(start, i))
+			start = -1
+		case !unicode.IsSpace(c) && start < 0:
+			start = i
+		}
+	}
+	if start >= 0 {
+		fs = append(fs, s.Slice(start, len(s.Text)))
+	}
+	return fs
+}
+
+// Split splits the span around each occurrence of sep.
+func Split(s Span, sep string) []Span {
+	var fs []Span
+	i := 0
+	for {
+		j := strings.Index(s.Text[i:], sep)
+		if j < 0 {
+			return append(fs, s.Slice(i, len(s.Text)))
+		}
+		fs = append(fs, s.Slice(i, i+j))
+		i += j + len(sep)
+	}
+}
+
+// Ints returns every integer found in the span, ignoring the text around
+// them. A minus sign directly before a number makes it negative, unless it
+// follows a digit, so that ranges such as "3-5" read as 3 and 5.
+func Ints(s Span) ([]int, error) {
+	var ns []int
+	t := s.Text
+	for i := 0; i < len(t); {
+		if !isDigit(t[i]) {
+			i++
+			continue
+		}
+		start := i
+		if i > 0 && t[i-1] == '-' && (i < 2 || !isDigit(t[i-2])) {
+			start--
+		}
+		for i < len(t) && isDigit(t[i]) {
+			i++
+		}
+		n, err := s.Slice(start, i).Int()
+		if err != nil {
+			return nil, err
+		}
+		ns = append(ns, n)
+	}
+	return ns, nil
+}
+
+// Digits returns the value of each character of the span, all of which must
+// be decimal digits.
+func Digits(s Span) ([]int, error) {
+	ds := make([]int, len(s.Text))
+	for i := range len(s.Text) {
+		if !isDigit(s.Text[i]) {
+			return nil, s.errorAt(i, "expected digit, got %q", s.Text[i])
+		}
+		ds[i] = int(s.Text[i] - '0')
+	}
+	return ds, nil
+}
+
+// Grid returns the characters of the given lines as rows of a rectangular
+// grid. It fails if the lines are not all the same length.
+func Grid(lines []Span) ([][]rune, error) {
+	if len(lines) == 0 {
+		return nil, errors.New("empty grid")
+	}
+	g := make([][]rune, len(lines))
+	for i, l := range lines {
+		g[i] = []rune(l.Text)
+		if len(g[i]) != len(g[0]) {
+			return nil, l.Errorf("grid row has %d columns, want %d", len(g[i]), len(g[0]))
+		}
+	}
+	return g, nil
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
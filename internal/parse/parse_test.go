@@ -0,0 +1,148 @@
+package parse
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// texts returns the text of each span.
+func texts(ss []Span) []string {
+	var ts []string
+	for _, s := range ss {
+		ts = append(ts, s.Text)
+	}
+	return ts
+}
+
+func TestLines(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want []string
+	}{
+		{"", nil},
+		{"a\nb", []string{"a", "b"}},
+		{"a\nb\n", []string{"a", "b"}},
+		{"a\r\nb\r\n", []string{"a", "b"}},
+		{"a\n\nb\n\n\n  \n", []string{"a", "", "b"}},
+		{"\n\na", []string{"", "", "a"}},
+	} {
+		ls, err := Lines(strings.NewReader(tc.in))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := texts(ls); !slices.Equal(got, tc.want) {
+			t.Errorf("Lines(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+		for i, l := range ls {
+			if l.Pos != (Pos{Line: i + 1, Col: 1}) {
+				t.Errorf("Lines(%q): line %d at %v", tc.in, i+1, l.Pos)
+			}
+		}
+	}
+}
+
+func TestSections(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want [][]string
+	}{
+		{"", nil},
+		{"a\nb\n", [][]string{{"a", "b"}}},
+		{"a\n\nb\nc\n", [][]string{{"a"}, {"b", "c"}}},
+		{"\n\na\r\n\r\n\r\nb\r\n\r\n", [][]string{{"a"}, {"b"}}},
+		{"a\n \t\nb", [][]string{{"a"}, {"b"}}},
+	} {
+		ss, err := Sections(strings.NewReader(tc.in))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got [][]string
+		for _, s := range ss {
+			got = append(got, texts(s))
+		}
+		if !slices.EqualFunc(got, tc.want, slices.Equal) {
+			t.Errorf("Sections(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+	// Lines keep their numbers across sections.
+	ss, _ := Sections(strings.NewReader("a\n\nb\n"))
+	if p := ss[1][0].Pos; p.Line != 3 {
+		t.Errorf("second section starts at %v, want line 3", p)
+	}
+}
+
+func TestInts(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want []int
+	}{
+		{"", nil},
+		{"p=0,4 v=3,-3", []int{0, 4, 3, -3}},
+		{"-1 -2 --3", []int{-1, -2, -3}},
+		{"3-5", []int{3, 5}},
+		{"x-5", []int{-5}},
+		{"Sensor at x=-2, y=15: 007", []int{-2, 15, 7}},
+		{"no numbers", nil},
+	} {
+		got, err := Ints(Span{Text: tc.in})
+		if err != nil || !slices.Equal(got, tc.want) {
+			t.Errorf("Ints(%q) = %v, %v, want %v", tc.in, got, err, tc.want)
+		}
+	}
+	if _, err := Ints(Span{Text: "99999999999999999999"}); err == nil {
+		t.Error("Ints read a number that overflows int")
+	}
+}
+
+func TestErrorPositions(t *testing.T) {
+	s := Span{Text: "ab 12 cd", Pos: Pos{File: "in.txt", Line: 7, Col: 1}}
+	fs := Fields(s)
+	if got := texts(fs); !slices.Equal(got, []string{"ab", "12", "cd"}) {
+		t.Fatalf("Fields = %q", got)
+	}
+	for _, tc := range []struct {
+		err  error
+		want string
+	}{
+		{s.Errorf("bad %s", "line"), "in.txt:7:1: bad line"},
+		{fs[2].Errorf("bad"), "in.txt:7:7: bad"},
+		{s.Slice(4, 5).Errorf("bad"), "in.txt:7:5: bad"},
+		{Split(s, " ")[1].Slice(1, 2).Errorf("bad"), "in.txt:7:5: bad"},
+		{func() error { _, err := fs[0].Int(); return err }(), `in.txt:7:1: invalid integer "ab"`},
+		{func() error { _, err := fs[2].TrimPrefix("x"); return err }(), `in.txt:7:7: expected "x"`},
+		{func() error { _, err := Digits(s); return err }(), `in.txt:7:1: expected digit, got 'a'`},
+		{func() error { _, err := Digits(fs[1].Slice(0, 2)); return err }(), ""},
+	} {
+		if tc.want == "" {
+			if tc.err != nil {
+				t.Errorf("got %v, want no error", tc.err)
+			}
+			continue
+		}
+		if tc.err == nil || tc.err.Error() != tc.want {
+			t.Errorf("got %v, want %s", tc.err, tc.want)
+		}
+		var pe *Error
+		if !errors.As(tc.err, &pe) {
+			t.Errorf("%v is not a positioned *Error", tc.err)
+		}
+	}
+	if p := (Pos{Line: 2, Col: 3}); p.String() != "2:3" {
+		t.Errorf("Pos without a file = %q, want 2:3", p)
+	}
+}
+
+func TestGrid(t *testing.T) {
+	ls, _ := Lines(strings.NewReader("ab\ncd\ne\n"))
+	if g, err := Grid(ls[:2]); err != nil || string(g[1]) != "cd" {
+		t.Errorf("Grid = %q, %v", g, err)
+	}
+	if _, err := Grid(ls); err == nil || err.Error() != "3:1: grid row has 1 columns, want 2" {
+		t.Errorf("Grid of a ragged input = %v", err)
+	}
+	if _, err := Grid(nil); err == nil {
+		t.Error("Grid of no lines succeeded")
+	}
+}
@@ -0,0 +1,88 @@
+package search
+
+import "container/heap"
+
+// Dijkstra searches from start for the cheapest paths to every reachable
+// state. Edge costs must not be negative.
+func Dijkstra[S comparable](start S, neighbors Neighbors[S]) *Result[S] {
+	return AStar(start, neighbors, nil, nil)
+}
+
+// AStar searches from start for the cheapest path to a state for which goal
+// returns true, guided by the estimate h of the remaining cost from a state to
+// the closest goal. The estimate must never exceed the actual cost, nor drop
+// by more than the cost of an edge along it. Once the first goal state is
+// settled, states at the same cost are still settled before returning, so
+// that the predecessors of every goal state as cheap as it are complete.
+//
+// A nil goal searches every reachable state, and a nil h is an estimate of 0.
+func AStar[S comparable](start S, neighbors Neighbors[S], goal func(S) bool, h func(S) int) *Result[S] {
+	if h == nil {
+		h = func(S) int { return 0 }
+	}
+	r := newResult(start)
+	settled := map[S]bool{}
+	q := &queue[S]{{state: start, dist: 0, prio: h(start)}}
+	found, best := false, 0
+	for q.Len() > 0 {
+		it := heap.Pop(q).(item[S])
+		if settled[it.state] || it.dist > r.Dist[it.state] {
+			continue
+		}
+		if found && it.prio > best {
+			break
+		}
+		settled[it.state] = true
+		if goal != nil && goal(it.state) && !found {
+			found, best = true, it.dist
+		}
+		for _, e := range neighbors(it.state) {
+			nd := it.dist + e.Cost
+			d, seen := r.Dist[e.To]
+			switch {
+			case !seen || nd < d:
+				r.Dist[e.To] = nd
+				r.Prev[e.To] = []S{it.state}
+				heap.Push(q, item[S]{state: e.To, dist: nd, prio: nd + h(e.To)})
+			case nd == d && !settled[e.To]:
+				r.Prev[e.To] = append(r.Prev[e.To], it.state)
+			}
+		}
+	}
+	return r
+}
+
+// item is a state queued for settling, at a distance from the start and with
+// its priority (the distance plus the estimate of the remaining cost).
+type item[S comparable] struct {
+	state      S
+	dist, prio int
+}
+
+// queue is a min-heap of items by priority, for container/heap. Ties go to
+// the item closest to the start: with an estimate, a state and the states
+// before it on its shortest paths can share a priority, and those must be
+// settled first for its predecessors to be complete.
+type queue[S comparable] []item[S]
+
+func (q queue[S]) Len() int { return len(q) }
+
+func (q queue[S]) Less(i, j int) bool {
+	if q[i].prio != q[j].prio {
+		return q[i].prio < q[j].prio
+	}
+	return q[i].dist < q[j].dist
+}
+
+func (q queue[S]) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *queue[S]) Push(x any) {
+	*q = append(*q, x.(item[S]))
+}
+
+func (q *queue[S]) Pop() any {
+	old := *q
+	it := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return it
+}
@@ -0,0 +1,112 @@
+// Package search finds shortest paths through graphs given implicitly by a
+// function from each state to its neighbors. States can be anything
+// comparable, such as a grid position, or a position together with a facing.
+package search
+
+import "slices"
+
+// Edge leads to a neighboring state, at the given cost.
+type Edge[S comparable] struct {
+	To   S
+	Cost int
+}
+
+// Neighbors returns the edges leaving a state.
+type Neighbors[S comparable] func(S) []Edge[S]
+
+// Result holds the outcome of a search from a start state.
+type Result[S comparable] struct {
+	Start S
+	// Dist holds the length of the shortest path to each reached state.
+	Dist map[S]int
+	// Prev holds, for each reached state but the start, every state
+	// preceding it on one of its shortest paths.
+	Prev map[S][]S
+}
+
+func newResult[S comparable](start S) *Result[S] {
+	return &Result[S]{
+		Start: start,
+		Dist:  map[S]int{start: 0},
+		Prev:  map[S][]S{},
+	}
+}
+
+// Reached returns whether s was reached by the search.
+func (r *Result[S]) Reached(s S) bool {
+	_, ok := r.Dist[s]
+	return ok
+}
+
+// Path returns one of the shortest paths from the start to s, both included,
+// or nil if s was not reached.
+func (r *Result[S]) Path(s S) []S {
+	if !r.Reached(s) {
+		return nil
+	}
+	p := []S{s}
+	for s != r.Start {
+		s = r.Prev[s][0]
+		p = append(p, s)
+	}
+	slices.Reverse(p)
+	return p
+}
+
+// OnShortestPaths returns every state lying on any of the shortest paths from
+// the start to the given states, which are included if they were reached.
+func (r *Result[S]) OnShortestPaths(to ...S) map[S]struct{} {
+	on := map[S]struct{}{}
+	var toProcess []S
+	for _, s := range to {
+		if r.Reached(s) {
+			toProcess = append(toProcess, s)
+		}
+	}
+	for len(toProcess) > 0 {
+		s := toProcess[len(toProcess)-1]
+		toProcess = toProcess[:len(toProcess)-1]
+		if _, ok := on[s]; ok {
+			continue
+		}
+		on[s] = struct{}{}
+		toProcess = append(toProcess, r.Prev[s]...)
+	}
+	return on
+}
+
+// BFS searches breadth first from start, where every step from a state to one
+// of the next ones costs 1. The search stops early once every state as close
+// as the first one for which done returns true has been reached; done may be
+// nil to reach every state.
+func BFS[S comparable](start S, next func(S) []S, done func(S) bool) *Result[S] {
+	r := newResult(start)
+	if done != nil && done(start) {
+		return r
+	}
+	stop := -1
+	toProcess := []S{start}
+	for len(toProcess) > 0 {
+		s := toProcess[0]
+		toProcess = toProcess[1:]
+		d := r.Dist[s] + 1
+		if stop >= 0 && d > stop {
+			break
+		}
+		for _, n := range next(s) {
+			nd, seen := r.Dist[n]
+			switch {
+			case !seen:
+				r.Dist[n] = d
+				r.Prev[n] = []S{s}
+				toProcess = append(toProcess, n)
+				if stop < 0 && done != nil && done(n) {
+					stop = d
+				}
+			case nd == d:
+				r.Prev[n] = append(r.Prev[n], s)
+			}
+		}
+	}
+	return r
+}
@@ -0,0 +1,240 @@
+package search
+
+import (
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// graph is a directed graph of n states, 0 to n-1, given by its edges.
+type graph map[int][]Edge[int]
+
+// randomGraph returns a graph with many ties between path lengths, from small
+// costs, and parallel edges.
+func randomGraph(rng *rand.Rand, n, edges, maxCost int) graph {
+	g := graph{}
+	for range edges {
+		from := rng.IntN(n)
+		g[from] = append(g[from], Edge[int]{To: rng.IntN(n), Cost: 1 + rng.IntN(maxCost)})
+	}
+	return g
+}
+
+func (g graph) neighbors(s int) []Edge[int] {
+	return g[s]
+}
+
+func (g graph) next(s int) []int {
+	var ns []int
+	for _, e := range g[s] {
+		ns = append(ns, e.To)
+	}
+	return ns
+}
+
+// distances returns the lengths of the shortest paths from start, by
+// Bellman-Ford.
+func (g graph) distances(start int) map[int]int {
+	d := map[int]int{start: 0}
+	for changed := true; changed; {
+		changed = false
+		for from, es := range g {
+			df, ok := d[from]
+			if !ok {
+				continue
+			}
+			for _, e := range es {
+				if dt, ok := d[e.To]; !ok || df+e.Cost < dt {
+					d[e.To] = df + e.Cost
+					changed = true
+				}
+			}
+		}
+	}
+	return d
+}
+
+// prevs returns every state before s on one of its shortest paths from the
+// start, given the distances.
+func (g graph) prevs(d map[int]int, s int) []int {
+	set := map[int]bool{}
+	for from, es := range g {
+		for _, e := range es {
+			if df, ok := d[from]; ok && e.To == s && df+e.Cost == d[s] {
+				set[from] = true
+			}
+		}
+	}
+	return slices.Sorted(maps.Keys(set))
+}
+
+// checkPrev checks that the predecessors of s found by a search are all of
+// those on its shortest paths.
+func checkPrev(t *testing.T, g graph, want map[int]int, r *Result[int], s int) {
+	t.Helper()
+	if s == r.Start {
+		return
+	}
+	got := slices.Sorted(maps.Keys(setOf(r.Prev[s])))
+	if w := g.prevs(want, s); !slices.Equal(got, w) {
+		t.Fatalf("Prev[%d] = %v, want %v", s, got, w)
+	}
+}
+
+func setOf(ss []int) map[int]bool {
+	m := map[int]bool{}
+	for _, s := range ss {
+		m[s] = true
+	}
+	return m
+}
+
+func TestDijkstra(t *testing.T) {
+	rng := rand.New(rand.NewPCG(5, 2024))
+	for range 200 {
+		g := randomGraph(rng, 30, 90, 3)
+		want := g.distances(0)
+		r := Dijkstra(0, g.neighbors)
+		if !maps.Equal(r.Dist, want) {
+			t.Fatalf("Dist = %v, want %v", r.Dist, want)
+		}
+		for s := range want {
+			checkPrev(t, g, want, r, s)
+		}
+	}
+}
+
+func TestAStar(t *testing.T) {
+	rng := rand.New(rand.NewPCG(5, 2025))
+	for range 200 {
+		g := randomGraph(rng, 30, 90, 3)
+		want := g.distances(0)
+		goal := func(s int) bool { return s >= 25 }
+		best, found := 0, false
+		for s, d := range want {
+			if goal(s) && (!found || d < best) {
+				best, found = d, true
+			}
+		}
+		r := AStar(0, g.neighbors, goal, nil)
+		for s, d := range r.Dist {
+			if d < want[s] {
+				t.Fatalf("Dist[%d] = %d, shorter than %d", s, d, want[s])
+			}
+		}
+		// Every goal state as close as the closest one is settled, with all
+		// its predecessors.
+		for s, d := range want {
+			if goal(s) && d == best {
+				if r.Dist[s] != d {
+					t.Fatalf("Dist[%d] = %d, want %d", s, r.Dist[s], d)
+				}
+				checkPrev(t, g, want, r, s)
+			}
+		}
+	}
+}
+
+// open is a w by h grid without walls, where every step costs 1.
+type open struct{ w, h int }
+
+func (o open) next(p [2]int) [][2]int {
+	var ns [][2]int
+	for _, d := range [][2]int{{0, 1}, {1, 0}, {0, -1}, {-1, 0}} {
+		n := [2]int{p[0] + d[0], p[1] + d[1]}
+		if n[0] >= 0 && n[1] >= 0 && n[0] < o.w && n[1] < o.h {
+			ns = append(ns, n)
+		}
+	}
+	return ns
+}
+
+func (o open) neighbors(p [2]int) []Edge[[2]int] {
+	var es []Edge[[2]int]
+	for _, n := range o.next(p) {
+		es = append(es, Edge[[2]int]{To: n, Cost: 1})
+	}
+	return es
+}
+
+// paths counts the shortest paths from the start to s.
+func paths[S comparable](r *Result[S], s S) int {
+	if s == r.Start {
+		return 1
+	}
+	n := 0
+	for _, p := range r.Prev[s] {
+		n += paths(r, p)
+	}
+	return n
+}
+
+func TestShortestPathsAcrossGrid(t *testing.T) {
+	o := open{4, 3}
+	start, end := [2]int{0, 0}, [2]int{3, 2}
+	manhattan := func(p [2]int) int { return end[0] - p[0] + end[1] - p[1] }
+	for name, r := range map[string]*Result[[2]int]{
+		"BFS":      BFS(start, o.next, func(p [2]int) bool { return p == end }),
+		"Dijkstra": Dijkstra(start, o.neighbors),
+		"AStar":    AStar(start, o.neighbors, func(p [2]int) bool { return p == end }, manhattan),
+	} {
+		if r.Dist[end] != 5 {
+			t.Errorf("%s: Dist[end] = %d, want 5", name, r.Dist[end])
+		}
+		// 5 steps, 3 of them right: 10 ways.
+		if got := paths(r, end); got != 10 {
+			t.Errorf("%s: %d shortest paths, want 10", name, got)
+		}
+		if got := len(r.OnShortestPaths(end)); got != 12 {
+			t.Errorf("%s: %d states on the shortest paths, want all 12", name, got)
+		}
+		if p := r.Path(end); len(p) != 6 || p[0] != start || p[5] != end {
+			t.Errorf("%s: Path(end) = %v", name, p)
+		}
+	}
+}
+
+func TestBFS(t *testing.T) {
+	rng := rand.New(rand.NewPCG(5, 2026))
+	for range 200 {
+		g := randomGraph(rng, 30, 70, 1)
+		want := g.distances(0)
+		r := BFS(0, g.next, nil)
+		if !maps.Equal(r.Dist, want) {
+			t.Fatalf("Dist = %v, want %v", r.Dist, want)
+		}
+		for s := range want {
+			checkPrev(t, g, want, r, s)
+		}
+		// Stopping early still completes the states as close as the first
+		// one done.
+		done := func(s int) bool { return s%7 == 6 }
+		r = BFS(0, g.next, done)
+		stop := -1
+		for s, d := range want {
+			if done(s) && (stop < 0 || d < stop) {
+				stop = d
+			}
+		}
+		for s, d := range want {
+			if d <= stop {
+				if r.Dist[s] != d {
+					t.Fatalf("early BFS: Dist[%d] = %d, want %d", s, r.Dist[s], d)
+				}
+				checkPrev(t, g, want, r, s)
+			}
+		}
+	}
+}
+
+func TestUnreached(t *testing.T) {
+	g := graph{0: {{To: 1, Cost: 1}}, 2: {{To: 0, Cost: 1}}}
+	r := Dijkstra(0, g.neighbors)
+	if r.Reached(2) || r.Path(2) != nil || len(r.OnShortestPaths(2)) != 0 {
+		t.Error("state 2 is reached against its only edge")
+	}
+	if got := r.OnShortestPaths(1, 2); len(got) != 2 {
+		t.Errorf("OnShortestPaths(1, 2) = %v, want 0 and 1", got)
+	}
+}
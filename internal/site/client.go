@@ -0,0 +1,117 @@
+// Package site talks to the Advent of Code website: it downloads puzzle
+// inputs into a local cache, and submits answers while keeping a history of
+// the attempts. Every request carries the user's session cookie
+// and a User-Agent naming this repository, and requests are spaced out so
+// that the site is never hammered.
+package site
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBaseURL is the address of the Advent of Code website.
+	DefaultBaseURL = "https://adventofcode.com"
+	// DefaultUserAgent identifies this repository to the site, as its
+	// maintainers ask of automated tools.
+	DefaultUserAgent = "github.com/liviro/aoc"
+	// DefaultInterval is the time left between two requests to the site.
+	DefaultInterval = 3 * time.Second
+)
+
+// Client sends requests to the site on behalf of a logged-in user.
+type Client struct {
+	// BaseURL is the address of the site, without a trailing slash.
+	BaseURL string
+	// Session is the value of the user's session cookie.
+	Session string
+	// UserAgent is sent with every request.
+	UserAgent string
+	// Interval is the least time left between the start of two requests.
+	Interval time.Duration
+	// HTTP sends the requests.
+	HTTP *http.Client
+
+	mu   sync.Mutex
+	last time.Time
+}
+
+// NewClient returns a client for the site at baseURL, logged in with the
+// given session cookie, with the default User-Agent and interval.
+func NewClient(baseURL, session string) *Client {
+	return &Client{
+		BaseURL:   strings.TrimRight(baseURL, "/"),
+		Session:   session,
+		UserAgent: DefaultUserAgent,
+		Interval:  DefaultInterval,
+		HTTP:      http.DefaultClient,
+	}
+}
+
+// Input downloads the puzzle input of the given year and day.
+func (c *Client) Input(year, day int) ([]byte, error) {
+	req, err := c.request(http.MethodGet, fmt.Sprintf("/%d/day/%d/input", year, day), nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(req)
+}
+
+func (c *Client) request(method, path string, body io.Reader) (*http.Request, error) {
+	if c.Session == "" {
+		return nil, errors.New("site: no session cookie configured")
+	}
+	req, err := http.NewRequest(method, c.BaseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", c.UserAgent)
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+	return req, nil
+}
+
+// do sends the request once the interval since the previous one has passed,
+// and returns the body of a successful response.
+func (c *Client) do(req *http.Request) ([]byte, error) {
+	c.wait()
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("site: %s %s: %s: %s", req.Method, req.URL.Path, resp.Status, summary(body))
+	}
+	return body, nil
+}
+
+func (c *Client) wait() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.last.IsZero() {
+		if d := c.Interval - time.Since(c.last); d > 0 {
+			time.Sleep(d)
+		}
+	}
+	c.last = time.Now()
+}
+
+// summary returns the first line of an error page, as the site explains
+// failures there.
+func summary(body []byte) string {
+	s, _, _ := strings.Cut(strings.TrimSpace(string(body)), "\n")
+	if len(s) > 200 {
+		s = s[:200] + "..."
+	}
+	return s
+}
@@ -0,0 +1,90 @@
+package site
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// DefaultHistoryPath is where submissions are recorded, relative to the
+// repository root.
+const DefaultHistoryPath = ".cache/submissions.json"
+
+// Attempt is a recorded submission of an answer.
+type Attempt struct {
+	Year    int       `json:"year"`
+	Day     int       `json:"day"`
+	Part    int       `json:"part"`
+	Answer  string    `json:"answer"`
+	Outcome Outcome   `json:"outcome"`
+	Time    time.Time `json:"time"`
+}
+
+// History is the record of every submission made, oldest first.
+type History []Attempt
+
+// LoadHistory reads the history at path. A missing file is an empty history.
+func LoadHistory(path string) (History, error) {
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var h History
+	if err := json.Unmarshal(raw, &h); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return h, nil
+}
+
+// Save writes the history to path.
+func (h History) Save(path string) error {
+	raw, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(raw, '\n'), 0o644)
+}
+
+// Check vets an answer about to be submitted to the given part of a puzzle
+// against the previous attempts. It returns an error if submitting is
+// pointless, as the part is solved or the answer is known to be wrong, and
+// warnings if the answer contradicts a "too high" or "too low" hint.
+func (h History) Check(year, day, part int, answer string) (warnings []string, err error) {
+	n, nerr := strconv.Atoi(answer)
+	for _, a := range h {
+		if a.Year != year || a.Day != day || a.Part != part {
+			continue
+		}
+		switch {
+		case a.Outcome == Correct:
+			return nil, fmt.Errorf("part already solved with %s", a.Answer)
+		case a.Answer == answer && a.Outcome.IsWrong():
+			return nil, fmt.Errorf("%s was already submitted on %s, and was %s", answer, a.Time.Format(time.DateTime), a.Outcome)
+		}
+		if nerr != nil {
+			continue
+		}
+		bound, err := strconv.Atoi(a.Answer)
+		if err != nil {
+			continue
+		}
+		switch {
+		case a.Outcome == TooHigh && n >= bound:
+			warnings = append(warnings, fmt.Sprintf("%s was too high, and %d is no lower", a.Answer, n))
+		case a.Outcome == TooLow && n <= bound:
+			warnings = append(warnings, fmt.Sprintf("%s was too low, and %d is no higher", a.Answer, n))
+		}
+	}
+	return warnings, nil
+}
@@ -0,0 +1,58 @@
+package site
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// DefaultInputsDir is where inputs are cached, relative to the repository
+// root. It is ignored by git, as inputs are personal to each user.
+const DefaultInputsDir = ".cache/inputs"
+
+// Inputs is an on-disk cache of puzzle inputs. An input is downloaded only
+// if it is not cached yet, and is never downloaded again afterwards.
+type Inputs struct {
+	Dir    string
+	Client *Client
+}
+
+// Path returns where the input of the given year and day is cached.
+func (in Inputs) Path(year, day int) string {
+	return filepath.Join(in.Dir, strconv.Itoa(year), fmt.Sprintf("day%02d.txt", day))
+}
+
+// Fetch makes sure that the input of the given year and day is cached,
+// downloading it if needed. It returns the cached input's path, and whether
+// it was downloaded.
+func (in Inputs) Fetch(year, day int) (path string, fetched bool, err error) {
+	path = in.Path(year, day)
+	if _, err := os.Stat(path); err == nil {
+		return path, false, nil
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return "", false, err
+	}
+	if in.Client == nil {
+		return "", false, fmt.Errorf("site: %d day %d input is not cached", year, day)
+	}
+	raw, err := in.Client.Input(year, day)
+	if err != nil {
+		return "", false, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", false, err
+	}
+	// Write under a temporary name first, so that an interrupted write never
+	// leaves a truncated input that would be taken for a cached one.
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, raw, 0o644); err != nil {
+		return "", false, err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return "", false, err
+	}
+	return path, true, nil
+}
@@ -0,0 +1,130 @@
+package site
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeSite stands in for the site, serving the input of 2024 day 1 to the
+// "abc" session, and counting the requests it receives.
+func fakeSite(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var n atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n.Add(1)
+		if got := r.Header.Get("User-Agent"); got != DefaultUserAgent {
+			t.Errorf("User-Agent = %q, want %q", got, DefaultUserAgent)
+		}
+		c, err := r.Cookie("session")
+		if err != nil || c.Value != "abc" {
+			http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+			return
+		}
+		if r.URL.Path != "/2024/day/1/input" {
+			http.Error(w, "Please don't repeatedly request this endpoint before it unlocks!", http.StatusNotFound)
+			return
+		}
+		w.Write([]byte("3   4\n4   3\n"))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &n
+}
+
+func TestInput(t *testing.T) {
+	srv, _ := fakeSite(t)
+	c := NewClient(srv.URL, "abc")
+	got, err := c.Input(2024, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "3   4\n4   3\n" {
+		t.Errorf("Input(2024, 1) = %q", got)
+	}
+}
+
+func TestInputErrors(t *testing.T) {
+	srv, n := fakeSite(t)
+	tests := []struct {
+		name, session string
+		year, day     int
+		want          string
+	}{
+		{"no session", "", 2024, 1, "no session cookie"},
+		{"logged out", "xyz", 2024, 1, "400 Bad Request: Puzzle inputs differ by user."},
+		{"locked", "abc", 2024, 2, "404 Not Found: Please don't repeatedly request"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(srv.URL, tt.session)
+			c.Interval = 0
+			_, err := c.Input(tt.year, tt.day)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Input(%d, %d) error = %v, want it to contain %q", tt.year, tt.day, err, tt.want)
+			}
+		})
+	}
+	if got := n.Load(); got != 2 {
+		t.Errorf("site got %d requests, want 2 as none is sent without a session", got)
+	}
+}
+
+func TestInputsFetchOnce(t *testing.T) {
+	srv, n := fakeSite(t)
+	in := Inputs{Dir: t.TempDir(), Client: NewClient(srv.URL, "abc")}
+
+	path, fetched, err := in.Fetch(2024, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !fetched {
+		t.Error("first Fetch did not download the input")
+	}
+	if want := filepath.Join(in.Dir, "2024", "day01.txt"); path != want {
+		t.Errorf("Fetch path = %q, want %q", path, want)
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil || string(raw) != "3   4\n4   3\n" {
+		t.Errorf("cached input = %q, %v", raw, err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, fetched, err := in.Fetch(2024, 1); err != nil || fetched {
+			t.Errorf("Fetch of a cached input = %t, %v; want no download", fetched, err)
+		}
+	}
+	if got := n.Load(); got != 1 {
+		t.Errorf("site got %d requests, want 1", got)
+	}
+}
+
+func TestInputsFailedFetchCachesNothing(t *testing.T) {
+	srv, _ := fakeSite(t)
+	in := Inputs{Dir: t.TempDir(), Client: NewClient(srv.URL, "abc")}
+	if _, _, err := in.Fetch(2024, 2); err == nil {
+		t.Fatal("Fetch of a locked input succeeded")
+	}
+	if _, err := os.Stat(in.Path(2024, 2)); err == nil {
+		t.Error("failed Fetch left a cached input")
+	}
+}
+
+func TestClientInterval(t *testing.T) {
+	srv, _ := fakeSite(t)
+	c := NewClient(srv.URL, "abc")
+	c.Interval = 50 * time.Millisecond
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := c.Input(2024, 1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if d := time.Since(start); d < 2*c.Interval {
+		t.Errorf("3 requests took %s, want at least %s", d, 2*c.Interval)
+	}
+}
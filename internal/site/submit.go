@@ -0,0 +1,129 @@
+package site
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Outcome is the site's judgement of a submitted answer.
+type Outcome int
+
+const (
+	// Unknown is a response the site has never been seen to give.
+	Unknown Outcome = iota
+	Correct
+	// Wrong is a wrong answer, without a hint of its direction.
+	Wrong
+	TooHigh
+	TooLow
+	// RateLimited means the answer was not judged, as the previous attempt
+	// was too recent.
+	RateLimited
+	// AlreadySolved means the answer was not judged, as the part is solved.
+	AlreadySolved
+)
+
+var outcomeNames = map[Outcome]string{
+	Unknown:       "unknown",
+	Correct:       "correct",
+	Wrong:         "wrong",
+	TooHigh:       "too high",
+	TooLow:        "too low",
+	RateLimited:   "rate limited",
+	AlreadySolved: "already solved",
+}
+
+func (o Outcome) String() string {
+	return outcomeNames[o]
+}
+
+// IsWrong returns whether the outcome rules the answer out.
+func (o Outcome) IsWrong() bool {
+	return o == Wrong || o == TooHigh || o == TooLow
+}
+
+func (o Outcome) MarshalText() ([]byte, error) {
+	return []byte(o.String()), nil
+}
+
+func (o *Outcome) UnmarshalText(b []byte) error {
+	for k, v := range outcomeNames {
+		if v == string(b) {
+			*o = k
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown outcome %q", b)
+}
+
+// Verdict is the site's response to a submitted answer.
+type Verdict struct {
+	Outcome Outcome
+	// Wait is how long to wait before the next attempt, when the site says.
+	Wait time.Duration
+	// Message is the text of the response, for the user to read.
+	Message string
+}
+
+// Submit submits the answer to the given part, 1 or 2, of the puzzle of the
+// given year and day.
+func (c *Client) Submit(year, day, part int, answer string) (Verdict, error) {
+	form := url.Values{"level": {strconv.Itoa(part)}, "answer": {answer}}
+	req, err := c.request(http.MethodPost, fmt.Sprintf("/%d/day/%d/answer", year, day), strings.NewReader(form.Encode()))
+	if err != nil {
+		return Verdict{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	body, err := c.do(req)
+	if err != nil {
+		return Verdict{}, err
+	}
+	return ParseVerdict(body), nil
+}
+
+var (
+	articleRE = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagRE     = regexp.MustCompile(`<[^>]*>`)
+	spaceRE   = regexp.MustCompile(`\s+`)
+	waitRE    = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+)
+
+// ParseVerdict reads the verdict out of the page the site responds with to a
+// submission.
+func ParseVerdict(page []byte) Verdict {
+	msg := string(page)
+	if m := articleRE.FindStringSubmatch(msg); m != nil {
+		msg = m[1]
+	}
+	msg = strings.TrimSpace(spaceRE.ReplaceAllString(tagRE.ReplaceAllString(msg, ""), " "))
+
+	v := Verdict{Message: msg}
+	switch {
+	case strings.Contains(msg, "That's the right answer"):
+		v.Outcome = Correct
+	case strings.Contains(msg, "That's not the right answer"):
+		switch {
+		case strings.Contains(msg, "your answer is too high"):
+			v.Outcome = TooHigh
+		case strings.Contains(msg, "your answer is too low"):
+			v.Outcome = TooLow
+		default:
+			v.Outcome = Wrong
+		}
+	case strings.Contains(msg, "You gave an answer too recently"):
+		v.Outcome = RateLimited
+	case strings.Contains(msg, "You don't seem to be solving the right level"):
+		v.Outcome = AlreadySolved
+	}
+	if m := waitRE.FindStringSubmatch(msg); m != nil {
+		mins, _ := strconv.Atoi(m[1])
+		secs, _ := strconv.Atoi(m[2])
+		v.Wait = time.Duration(mins)*time.Minute + time.Duration(secs)*time.Second
+	}
+	return v
+}
@@ -0,0 +1,131 @@
+package site
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// page wraps a message the way the site does in response to a submission.
+func page(msg string) string {
+	return `<!DOCTYPE html><html><body><main>
+<article><p>` + msg + `</p></article>
+</main></body></html>`
+}
+
+func TestParseVerdict(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  string
+		want Outcome
+		wait time.Duration
+	}{
+		{"correct", `That's the right answer!  You are <span class="day-success">one gold star</span> closer to finding the Chief Historian. <a href="/2024/day/16#part2">[Continue to Part Two]</a>`, Correct, 0},
+		{"too high", `That's not the right answer; your answer is too high.  If you're stuck, make sure you're using the full input data. Please wait one minute before trying again. <a href="/2024/day/16">[Return to Day 16]</a>`, TooHigh, 0},
+		{"too low", `That's not the right answer; your answer is too low.  Please wait one minute before trying again.`, TooLow, 0},
+		{"wrong", `That's not the right answer.  If you're stuck, make sure you're using the full input data. Please wait one minute before trying again.`, Wrong, 0},
+		{"rate limited", `You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 38s left to wait. <a href="/2024/day/16">[Return to Day 16]</a>`, RateLimited, 38 * time.Second},
+		{"rate limited minutes", `You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 4m 2s left to wait.`, RateLimited, 4*time.Minute + 2*time.Second},
+		{"already solved", `You don't seem to be solving the right level.  Did you already complete it? <a href="/2024/day/16">[Return to Day 16]</a>`, AlreadySolved, 0},
+		{"unknown", `Something else entirely.`, Unknown, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := ParseVerdict([]byte(page(tt.msg)))
+			if v.Outcome != tt.want || v.Wait != tt.wait {
+				t.Errorf("ParseVerdict() = %s, wait %s; want %s, wait %s", v.Outcome, v.Wait, tt.want, tt.wait)
+			}
+			if strings.ContainsAny(v.Message, "<>") {
+				t.Errorf("ParseVerdict() message %q holds markup", v.Message)
+			}
+		})
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/2024/day/16/answer" {
+			http.NotFound(w, r)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+		if r.PostForm.Get("level") != "1" {
+			t.Errorf("level = %q, want 1", r.PostForm.Get("level"))
+		}
+		if r.PostForm.Get("answer") == "72428" {
+			w.Write([]byte(page("That's the right answer!")))
+			return
+		}
+		w.Write([]byte(page("That's not the right answer; your answer is too high.")))
+	}))
+	defer srv.Close()
+	c := NewClient(srv.URL, "abc")
+	c.Interval = 0
+
+	for answer, want := range map[string]Outcome{"72428": Correct, "80000": TooHigh} {
+		v, err := c.Submit(2024, 16, 1, answer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.Outcome != want {
+			t.Errorf("Submit(%s) = %s, want %s", answer, v.Outcome, want)
+		}
+	}
+}
+
+func TestHistoryCheck(t *testing.T) {
+	h := History{
+		{Year: 2024, Day: 16, Part: 1, Answer: "80000", Outcome: TooHigh},
+		{Year: 2024, Day: 16, Part: 1, Answer: "70000", Outcome: TooLow},
+		{Year: 2024, Day: 16, Part: 1, Answer: "75000", Outcome: RateLimited},
+		{Year: 2024, Day: 16, Part: 2, Answer: "456", Outcome: Correct},
+		{Year: 2024, Day: 17, Part: 1, Answer: "1,2,3", Outcome: Wrong},
+	}
+	tests := []struct {
+		day, part int
+		answer    string
+		warnings  int
+		refused   bool
+	}{
+		{16, 1, "72428", 0, false},
+		{16, 1, "75000", 0, false}, // Never judged.
+		{16, 1, "80000", 0, true},
+		{16, 1, "70000", 0, true},
+		{16, 1, "90000", 1, false},
+		{16, 1, "60000", 1, false},
+		{16, 2, "453", 0, true}, // Already solved.
+		{17, 1, "1,2,3", 0, true},
+		{17, 1, "3,2,1", 0, false},
+		{17, 2, "1,2,3", 0, false},
+	}
+	for _, tt := range tests {
+		warnings, err := h.Check(2024, tt.day, tt.part, tt.answer)
+		if (err != nil) != tt.refused || len(warnings) != tt.warnings {
+			t.Errorf("Check(day %d, part %d, %s) = %q, %v; want %d warnings, refused %t",
+				tt.day, tt.part, tt.answer, warnings, err, tt.warnings, tt.refused)
+		}
+	}
+}
+
+func TestHistorySaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sub", "history.json")
+	if h, err := LoadHistory(path); err != nil || len(h) != 0 {
+		t.Fatalf("LoadHistory of a missing file = %v, %v; want empty", h, err)
+	}
+	h := History{{Year: 2024, Day: 16, Part: 1, Answer: "80000", Outcome: TooHigh, Time: time.Date(2024, 12, 16, 6, 0, 0, 0, time.UTC)}}
+	if err := h.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	got, err := LoadHistory(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0] != h[0] {
+		t.Errorf("LoadHistory() = %v, want %v", got, h)
+	}
+}